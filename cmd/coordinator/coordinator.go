@@ -1,9 +1,14 @@
 package main
 
 import (
+	"github.com/sportshead/powergrid/internal/coordinator/capture"
 	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
 	"github.com/sportshead/powergrid/internal/coordinator/http"
 	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/linkedroles"
+	"github.com/sportshead/powergrid/internal/coordinator/queue"
+	"github.com/sportshead/powergrid/internal/coordinator/ratelimit"
 	"github.com/sportshead/powergrid/pkg/utils"
 	"github.com/sportshead/powergrid/pkg/version"
 	"log/slog"
@@ -14,25 +19,47 @@ import (
 	"time"
 )
 
-var stop = make(chan struct{})
+var stop = utils.NewStopper()
 var cleanupGroup = &sync.WaitGroup{}
 
+// cleanupMargin is how long cleanup is waited for after the drain delay and shutdown timeout, for the http package to log abandoned interactions.
+const cleanupMargin = 5 * time.Second
+
 func main() {
 	slog.Info("starting coordinator", utils.Tag("start"), slog.String("version", version.String))
 
 	discord.Init()
 	kubernetes.Init(stop, cleanupGroup)
 
+	if env.LinkedRolesTokenStore == "memory" {
+		linkedroles.Init(&linkedroles.MemoryTokenStore{})
+	} else {
+		linkedroles.Init(kubernetes.SecretTokenStore{})
+	}
+
+	ratelimit.Init()
+	queue.Init(env.DeliveryQueue, env.DeliveryQueueDir, env.NATSURL)
+
+	err := capture.Init(env.Capture, env.CaptureFile, env.CaptureSize)
+	if err != nil {
+		slog.Error("failed to initiate capture", utils.Tag("capture_failed"), utils.Error(err), slog.String("capture", env.Capture))
+		os.Exit(1)
+	}
+
 	http.Init(stop, cleanupGroup)
 
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
 
-	<-ch
+	select {
+	case <-ch:
+	case <-stop.Done():
+	}
 	slog.Info("gracefully stopping coordinator", utils.Tag("stopping"))
-	close(stop)
+	stop.Stop()
 
-	if !utils.WaitTimeout(cleanupGroup, 10*time.Second) {
+	// in-flight interactions are abandoned by the http package once the drain delay and shutdown timeout are reached
+	if utils.WaitTimeout(cleanupGroup, env.DrainDelay+env.ShutdownTimeout+cleanupMargin) {
 		slog.Error("cleanup timed out", utils.Tag("cleanup_timeout"))
 		os.Exit(1)
 	} else {
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/appcommand"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"os"
+	"slices"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around changes.
+const diffContext = 3
+
+// diff compares Command manifests with the commands registered on Discord, showing what the coordinator would create, update and delete.
+// All the manifests are assumed to be in watched namespaces. Returns an error if there are differences, like kubectl diff.
+func diff(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	var files filesFlag
+	flags.Var(&files, "f", "Command manifest file or directory, - for stdin, can be repeated")
+	namespace := flags.String("namespace", "default", "namespace of objects without one")
+	discord := addDiscordFlags(flags)
+	_ = flags.Parse(args)
+
+	if len(files) == 0 {
+		return errors.New("-f is required")
+	}
+	m, err := readManifests(files, *namespace)
+	if err != nil {
+		return err
+	}
+	registered, err := discord.registeredCommands()
+	if err != nil {
+		return fmt.Errorf("failed to get commands: %w", err)
+	}
+
+	var created, updated, deleted, unchanged int
+	// commands are matched by name in order, as in a sync
+	remaining := slices.Clone(m.commands)
+	for _, oldCommand := range registered {
+		i := slices.IndexFunc(remaining, func(cmd *powergridv10.Command) bool {
+			command, err := appcommand.Parse(cmd, nil)
+			return err == nil && command.Name == oldCommand.Name
+		})
+		if i == -1 {
+			fmt.Printf("- %s\n", oldCommand.Name)
+			deleted++
+			continue
+		}
+
+		cmd := remaining[i]
+		remaining = slices.Delete(remaining, i, i+1)
+		newCommand, err := appcommand.Parse(cmd, oldCommand)
+		if err != nil {
+			return fmt.Errorf("%s: failed to parse command: %w", m.describe(cmd), err)
+		}
+		if appcommand.Equal(oldCommand, newCommand) {
+			unchanged++
+			continue
+		}
+
+		fmt.Printf("~ %s (%s)\n", oldCommand.Name, m.describe(cmd))
+		for _, line := range diffLines(commandLines(oldCommand), commandLines(newCommand)) {
+			fmt.Println("    " + line)
+		}
+		updated++
+	}
+
+	for _, cmd := range remaining {
+		command, err := appcommand.Parse(cmd, nil)
+		if err != nil {
+			return fmt.Errorf("%s: failed to parse command: %w", m.describe(cmd), err)
+		}
+		fmt.Printf("+ %s (%s)\n", command.Name, m.describe(cmd))
+		created++
+	}
+
+	fmt.Fprintf(os.Stderr, "%d to create, %d to update, %d to delete, %d unchanged\n", created, updated, deleted, unchanged)
+	if created+updated+deleted > 0 {
+		return errors.New("commands differ")
+	}
+	return nil
+}
+
+func commandLines(command *discordgo.ApplicationCommand) []string {
+	b, err := json.MarshalIndent(command, "", "  ")
+	if err != nil {
+		return []string{err.Error()}
+	}
+	return strings.Split(string(b), "\n")
+}
+
+// diffLines returns the lines which differ between a and b prefixed with - and +, around a few unchanged lines.
+func diffLines(a []string, b []string) []string {
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	var changed []bool
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, "  "+a[i])
+			changed = append(changed, false)
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lengths[i][j+1] >= lengths[i+1][j]):
+			lines = append(lines, "+ "+b[j])
+			changed = append(changed, true)
+			j++
+		default:
+			lines = append(lines, "- "+a[i])
+			changed = append(changed, true)
+			i++
+		}
+	}
+
+	var out []string
+	skipped := false
+	for k, line := range lines {
+		near := false
+		for c := max(0, k-diffContext); c <= min(len(lines)-1, k+diffContext); c++ {
+			near = near || changed[c]
+		}
+		if !near {
+			skipped = true
+			continue
+		}
+		if skipped && len(out) > 0 {
+			out = append(out, "  ...")
+		}
+		skipped = false
+		out = append(out, line)
+	}
+	return out
+}
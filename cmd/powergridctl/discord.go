@@ -0,0 +1,39 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"github.com/bwmarrin/discordgo"
+	"os"
+)
+
+// discordFlags select the application commands for subcommands which read them from Discord.
+// The bot token is read from DISCORD_BOT_TOKEN, as the coordinator does, so that it isn't in the shell history.
+type discordFlags struct {
+	application *string
+	guild       *string
+}
+
+func addDiscordFlags(flags *flag.FlagSet) *discordFlags {
+	return &discordFlags{
+		application: flags.String("app", os.Getenv("DISCORD_APPLICATION_ID"), "ID of the application, defaults to DISCORD_APPLICATION_ID"),
+		guild:       flags.String("guild", os.Getenv("DISCORD_GUILD_ID"), "ID of the guild for guild commands, defaults to DISCORD_GUILD_ID, global commands if unset"),
+	}
+}
+
+// registeredCommands lists the application commands registered on Discord.
+func (f *discordFlags) registeredCommands() ([]*discordgo.ApplicationCommand, error) {
+	token := os.Getenv("DISCORD_BOT_TOKEN")
+	if token == "" {
+		return nil, errors.New("DISCORD_BOT_TOKEN is required")
+	}
+	if *f.application == "" {
+		return nil, errors.New("-app is required")
+	}
+
+	session, err := discordgo.New("Bot " + token)
+	if err != nil {
+		return nil, err
+	}
+	return session.ApplicationCommands(*f.application, *f.guild)
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sigs.k8s.io/yaml"
+	"strings"
+)
+
+// invalidNameCharacters are the runs of characters which can't be in the name of a Kubernetes object.
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// exportedObjectMeta leaves out the empty fields of metav1.ObjectMeta, such as creationTimestamp.
+type exportedObjectMeta struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type exportedCommand struct {
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Metadata   exportedObjectMeta       `json:"metadata"`
+	Spec       powergridv10.CommandSpec `json:"spec"`
+}
+
+// export writes Command manifests for the commands registered on Discord, so that existing commands can be moved to powergrid.
+// The manifests keep every field Discord returns, so that powergridctl diff shows them as unchanged.
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	service := flags.String("service", "", "serviceName of the exported Commands")
+	namespace := flags.String("namespace", "", "namespace of the exported Commands, left unset if empty")
+	out := flags.String("o", "", "directory to write a file for each Command to, stdout if unset")
+	discord := addDiscordFlags(flags)
+	_ = flags.Parse(args)
+
+	if *service == "" {
+		return errors.New("-service is required")
+	}
+	registered, err := discord.registeredCommands()
+	if err != nil {
+		return fmt.Errorf("failed to get commands: %w", err)
+	}
+	if *out != "" {
+		err = os.MkdirAll(*out, 0o755)
+		if err != nil {
+			return err
+		}
+	}
+
+	names := make(map[string]bool)
+	for i, command := range registered {
+		name := objectName(command, names)
+		names[name] = true
+
+		// set by Discord, and ignored in manifests
+		command.ID = ""
+		command.ApplicationID = ""
+		command.GuildID = ""
+		command.Version = ""
+		raw, err := json.Marshal(command)
+		if err != nil {
+			return err
+		}
+
+		err = writeCommand(*out, i, command.Name, exportedCommand{
+			APIVersion: powergridv10.SchemeGroupVersion.String(),
+			Kind:       "Command",
+			Metadata:   exportedObjectMeta{Name: name, Namespace: *namespace},
+			Spec: powergridv10.CommandSpec{
+				ServiceName: *service,
+				Command:     apiextensionsv1.JSON{Raw: raw},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "exported %d commands\n", len(registered))
+	return nil
+}
+
+// writeCommand writes the i-th manifest to a file named after the object in the out directory, or to stdout if out is empty.
+func writeCommand(out string, i int, commandName string, manifest exportedCommand) error {
+	b, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		if i > 0 {
+			fmt.Println("---")
+		}
+		fmt.Print(string(b))
+		return nil
+	}
+	file := filepath.Join(out, manifest.Metadata.Name+".yaml")
+	err = os.WriteFile(file, b, 0o644)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "wrote %s to %s\n", commandName, file)
+	return nil
+}
+
+// objectName returns a unique Kubernetes object name for the command. Command names are short enough to always be valid.
+// User and message commands have the type appended if their name is taken, and names without any ASCII letters or numbers use the ID.
+func objectName(command *discordgo.ApplicationCommand, taken map[string]bool) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(command.Name), "-"), "-")
+	if name == "" {
+		name = "command-" + command.ID
+	}
+	if taken[name] {
+		switch command.Type {
+		case discordgo.UserApplicationCommand:
+			name += "-user"
+		case discordgo.MessageApplicationCommand:
+			name += "-message"
+		}
+	}
+	base := name
+	for i := 2; taken[name]; i++ {
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+	return name
+}
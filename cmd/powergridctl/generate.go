@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/sdk/gen"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"os"
+)
+
+// generate writes Command manifests for the marked option structs of a Go package, see pkg/sdk/gen. It is meant for go generate:
+//
+//	//go:generate go run github.com/sportshead/powergrid/cmd/powergridctl generate -service bot -o ../helm/commands
+//
+// The commands are linted first, and nothing is written if there are errors.
+func generate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	dir := flags.String("dir", ".", "directory of the Go package")
+	service := flags.String("service", "", "serviceName of Commands without a serviceName marker")
+	namespace := flags.String("namespace", "", "namespace of the generated Commands, left unset if empty")
+	out := flags.String("o", "", "directory to write a file for each Command to, stdout if unset")
+	_ = flags.Parse(args)
+
+	commands, err := gen.Generate(*dir)
+	if err != nil {
+		return err
+	}
+	if len(commands) == 0 {
+		return fmt.Errorf("no marked structs in %s", *dir)
+	}
+
+	manifests := make([]exportedCommand, 0, len(commands))
+	names := make(map[string]bool)
+	var errorCount int
+	for _, c := range commands {
+		if c.Spec.ServiceName == "" {
+			if *service == "" {
+				return errors.New("-service is required for commands without a serviceName marker")
+			}
+			c.Spec.ServiceName = *service
+		}
+		raw, err := json.Marshal(c.Command)
+		if err != nil {
+			return err
+		}
+		raw, err = withoutNulls(raw)
+		if err != nil {
+			return err
+		}
+		c.Spec.Command = apiextensionsv1.JSON{Raw: raw}
+
+		l := &linter{object: c.Source.String()}
+		lintCommand(l, &powergridv10.Command{Spec: c.Spec})
+		for _, problem := range l.problems {
+			fmt.Fprintln(os.Stderr, problem)
+			if !problem.warning {
+				errorCount++
+			}
+		}
+
+		name := objectName(c.Command, names)
+		names[name] = true
+		manifests = append(manifests, exportedCommand{
+			APIVersion: powergridv10.SchemeGroupVersion.String(),
+			Kind:       "Command",
+			Metadata:   exportedObjectMeta{Name: name, Namespace: *namespace},
+			Spec:       c.Spec,
+		})
+	}
+	if errorCount > 0 {
+		return fmt.Errorf("%d errors", errorCount)
+	}
+
+	if *out != "" {
+		err = os.MkdirAll(*out, 0o755)
+		if err != nil {
+			return err
+		}
+	}
+	for i, manifest := range manifests {
+		err = writeCommand(*out, i, commands[i].Command.Name, manifest)
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "generated %d commands\n", len(commands))
+	return nil
+}
+
+// withoutNulls removes the null fields of the JSON object, which discordgo marshals for options without choices or suboptions.
+func withoutNulls(raw []byte) ([]byte, error) {
+	var v interface{}
+	err := json.Unmarshal(raw, &v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(removeNulls(v))
+}
+
+func removeNulls(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if value == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = removeNulls(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = removeNulls(value)
+		}
+	}
+	return v
+}
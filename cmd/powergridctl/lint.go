@@ -0,0 +1,449 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/appcommand"
+	"github.com/sportshead/powergrid/internal/coordinator/static"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of application commands.
+// See https://discord.com/developers/docs/interactions/application-commands#application-command-object
+const (
+	maxNameLength        = 32
+	maxDescriptionLength = 100
+	maxOptions           = 25
+	maxChoices           = 25
+	maxChoiceLength      = 100
+	maxOptionLength      = 6000
+	// maxCommandLength is the limit of the combined length of the names, descriptions and choices of a command.
+	maxCommandLength = 4000
+	// maxChatCommands is the number of chat input commands an application can have, globally or in a guild.
+	maxChatCommands = 100
+)
+
+// chatNamePattern matches the names of chat input commands and options, which must also be lowercase.
+var chatNamePattern = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+// discordLocales are the locales of localizations. discordgo is missing some of them.
+// See https://discord.com/developers/docs/reference#locales
+var discordLocales = []discordgo.Locale{
+	"id", "da", "de", "en-GB", "en-US", "es-ES", "es-419", "fr", "hr", "it", "lt", "hu", "nl", "no", "pl", "pt-BR",
+	"ro", "fi", "sv-SE", "vi", "tr", "cs", "el", "bg", "ru", "uk", "hi", "th", "zh-CN", "ja", "zh-TW", "ko",
+}
+
+type lintProblem struct {
+	warning bool
+	object  string
+	path    string
+	message string
+}
+
+func (p lintProblem) String() string {
+	severity := "error"
+	if p.warning {
+		severity = "warning"
+	}
+	if p.path == "" {
+		return fmt.Sprintf("%s: %s: %s", p.object, severity, p.message)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s", p.object, severity, p.path, p.message)
+}
+
+// linter collects the problems of one Command object.
+type linter struct {
+	object   string
+	problems []lintProblem
+}
+
+func (l *linter) errorf(path string, format string, args ...interface{}) {
+	l.problems = append(l.problems, lintProblem{object: l.object, path: path, message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) warnf(path string, format string, args ...interface{}) {
+	l.problems = append(l.problems, lintProblem{warning: true, object: l.object, path: path, message: fmt.Sprintf(format, args...)})
+}
+
+// lint checks Command manifests against Discord's rules for application commands, before they are rejected by a sync.
+func lint(args []string) error {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	var files filesFlag
+	flags.Var(&files, "f", "Command manifest file or directory, - for stdin, can be repeated")
+	namespace := flags.String("namespace", "default", "namespace of objects without one")
+	_ = flags.Parse(args)
+
+	if len(files) == 0 {
+		return errors.New("-f is required")
+	}
+	m, err := readManifests(files, *namespace)
+	if err != nil {
+		return err
+	}
+
+	var problems []lintProblem
+	names := make(map[string][]string)
+	var chatCommands int
+	for _, cmd := range m.commands {
+		l := &linter{object: m.describe(cmd)}
+		command := lintCommand(l, cmd)
+		problems = append(problems, l.problems...)
+		if command == nil {
+			continue
+		}
+
+		names[command.Name] = append(names[command.Name], l.object)
+		if command.Type == discordgo.ChatApplicationCommand {
+			chatCommands++
+		}
+	}
+
+	for name, objects := range names {
+		if len(objects) > 1 {
+			problems = append(problems, lintProblem{
+				object:  strings.Join(objects, ", "),
+				message: fmt.Sprintf("%d Command objects have the name %q, interactions for it will fail", len(objects), name),
+			})
+		}
+	}
+	if chatCommands > maxChatCommands {
+		problems = append(problems, lintProblem{
+			object:  "manifests",
+			message: fmt.Sprintf("%d chat input commands, an application can have at most %d", chatCommands, maxChatCommands),
+		})
+	}
+
+	var errorCount int
+	for _, problem := range problems {
+		fmt.Println(problem)
+		if !problem.warning {
+			errorCount++
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%d commands, %d errors, %d warnings\n", len(m.commands), errorCount, len(problems)-errorCount)
+	if errorCount > 0 {
+		return fmt.Errorf("%d errors", errorCount)
+	}
+	return nil
+}
+
+// lintCommand checks the Command object, returning its application command if it can be parsed.
+func lintCommand(l *linter, cmd *powergridv10.Command) *discordgo.ApplicationCommand {
+	if len(cmd.Spec.Command.Raw) == 0 {
+		l.errorf("spec.command", "is required")
+		return nil
+	}
+
+	command, err := appcommand.Parse(cmd, nil)
+	if err != nil {
+		l.errorf("spec.command", "failed to parse: %v", err)
+		return nil
+	}
+
+	// discordgo drops fields it doesn't know, so they are never sent to Discord
+	decoder := json.NewDecoder(bytes.NewReader(cmd.Spec.Command.Raw))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(&discordgo.ApplicationCommand{}); err != nil {
+		l.warnf("spec.command", "%v, it will not be synced to Discord", err)
+	}
+	if command.ID != "" || command.ApplicationID != "" || command.Version != "" {
+		l.warnf("spec.command", "id, application_id and version are set by Discord, and ignored")
+	}
+
+	switch command.Type {
+	case discordgo.ChatApplicationCommand:
+		lintName(l, "spec.command.name", command.Name, localizations(command.NameLocalizations))
+		lintDescription(l, "spec.command.description", command.Description, localizations(command.DescriptionLocalizations))
+		lintOptions(l, "spec.command.options", command.Options, 0)
+	case discordgo.UserApplicationCommand, discordgo.MessageApplicationCommand:
+		if n := utf8.RuneCountInString(command.Name); n < 1 || n > maxNameLength {
+			l.errorf("spec.command.name", "must be 1-%d characters, is %d", maxNameLength, n)
+		}
+		lintLocalizations(l, "spec.command.name_localizations", localizations(command.NameLocalizations), 1, maxNameLength)
+		if command.Description != "" || command.DescriptionLocalizations != nil {
+			l.errorf("spec.command.description", "must be empty for user and message commands")
+		}
+		if len(command.Options) > 0 {
+			l.errorf("spec.command.options", "must be empty for user and message commands")
+		}
+	default:
+		l.errorf("spec.command.type", "unknown type %d", command.Type)
+	}
+
+	if n := commandLength(command); n > maxCommandLength {
+		l.errorf("spec.command", "names, descriptions and choices have %d characters combined, at most %d are allowed", n, maxCommandLength)
+	}
+
+	optionNames := make(map[string]bool)
+	var autocompletes bool
+	walkOptions(command.Options, func(option *discordgo.ApplicationCommandOption) {
+		optionNames[option.Name] = true
+		autocompletes = autocompletes || option.Autocomplete
+	})
+	if cmd.Spec.Audit != nil {
+		for i, name := range cmd.Spec.Audit.MaskedOptions {
+			if !optionNames[name] {
+				l.warnf(fmt.Sprintf("spec.audit.maskedOptions[%d]", i), "command has no option %q", name)
+			}
+		}
+	}
+	if cmd.Spec.AutocompleteCache != nil && !autocompletes {
+		l.warnf("spec.autocompleteCache", "command has no options with autocomplete")
+	}
+	if cmd.Spec.Response != nil {
+		if err := static.Validate(cmd.Spec.Response); err != nil {
+			for _, line := range strings.Split(err.Error(), "\n") {
+				l.errorf("spec.response", "%s", line)
+			}
+		}
+		if cmd.Spec.ServiceName != "" || len(cmd.Spec.Backends) > 0 || cmd.Spec.External != nil || len(cmd.Spec.GuildRoutes) > 0 {
+			l.warnf("spec.response", "the coordinator answers with the response, so services are never used")
+		}
+	}
+	return command
+}
+
+func localizations(m *map[discordgo.Locale]string) map[discordgo.Locale]string {
+	if m == nil {
+		return nil
+	}
+	return *m
+}
+
+func lintName(l *linter, path string, name string, localized map[discordgo.Locale]string) {
+	if !chatNamePattern.MatchString(name) {
+		l.errorf(path, "%q must be 1-%d letters, numbers, - or _", name, maxNameLength)
+	} else if strings.ToLower(name) != name {
+		l.errorf(path, "%q must be lowercase", name)
+	}
+	for _, locale := range sortedLocales(localized) {
+		value := localized[locale]
+		if !chatNamePattern.MatchString(value) || strings.ToLower(value) != value {
+			l.errorf(path+"_localizations."+string(locale), "%q must be 1-%d lowercase letters, numbers, - or _", value, maxNameLength)
+		}
+	}
+	lintLocalizations(l, path+"_localizations", localized, 1, maxNameLength)
+}
+
+func lintDescription(l *linter, path string, description string, localized map[discordgo.Locale]string) {
+	if n := utf8.RuneCountInString(description); n < 1 || n > maxDescriptionLength {
+		l.errorf(path, "must be 1-%d characters, is %d", maxDescriptionLength, n)
+	}
+	lintLocalizations(l, path+"_localizations", localized, 1, maxDescriptionLength)
+}
+
+func lintLocalizations(l *linter, path string, localized map[discordgo.Locale]string, minLength int, maxLength int) {
+	for _, locale := range sortedLocales(localized) {
+		if !slices.Contains(discordLocales, locale) {
+			l.errorf(path, "unknown locale %q", locale)
+		}
+		if n := utf8.RuneCountInString(localized[locale]); n < minLength || n > maxLength {
+			l.errorf(path+"."+string(locale), "must be %d-%d characters, is %d", minLength, maxLength, n)
+		}
+	}
+}
+
+func sortedLocales(localized map[discordgo.Locale]string) []discordgo.Locale {
+	locales := make([]discordgo.Locale, 0, len(localized))
+	for locale := range localized {
+		locales = append(locales, locale)
+	}
+	slices.Sort(locales)
+	return locales
+}
+
+func isSubcommand(option *discordgo.ApplicationCommandOption) bool {
+	return option.Type == discordgo.ApplicationCommandOptionSubCommand || option.Type == discordgo.ApplicationCommandOptionSubCommandGroup
+}
+
+// lintOptions checks the options at a depth of 0 for the command, 1 in a subcommand group or 2 in a subcommand.
+func lintOptions(l *linter, path string, options []*discordgo.ApplicationCommandOption, depth int) {
+	if len(options) > maxOptions {
+		l.errorf(path, "has %d options, at most %d are allowed", len(options), maxOptions)
+	}
+
+	names := make(map[string]bool)
+	var subcommands, optional bool
+	for i, option := range options {
+		path := fmt.Sprintf("%s[%d]", path, i)
+		if option == nil {
+			l.errorf(path, "must not be null")
+			continue
+		}
+
+		lintName(l, path+".name", option.Name, option.NameLocalizations)
+		if names[option.Name] {
+			l.errorf(path+".name", "duplicate option %q", option.Name)
+		}
+		names[option.Name] = true
+		lintDescription(l, path+".description", option.Description, option.DescriptionLocalizations)
+
+		switch {
+		case depth == 1 && option.Type != discordgo.ApplicationCommandOptionSubCommand:
+			l.errorf(path+".type", "subcommand groups can only contain subcommands")
+		case depth == 2 && isSubcommand(option):
+			l.errorf(path+".type", "subcommands cannot contain subcommands or groups")
+		case i > 0 && isSubcommand(option) != subcommands:
+			l.errorf(path+".type", "subcommands and groups cannot be mixed with other options")
+		}
+		subcommands = isSubcommand(option)
+
+		if isSubcommand(option) {
+			if option.Required || len(option.Choices) > 0 || option.Autocomplete {
+				l.errorf(path, "subcommands and groups cannot be required, or have choices or autocomplete")
+			}
+			depth := 2
+			if option.Type == discordgo.ApplicationCommandOptionSubCommandGroup {
+				depth = 1
+			}
+			lintOptions(l, path+".options", option.Options, depth)
+			continue
+		}
+
+		if len(option.Options) > 0 {
+			l.errorf(path+".options", "only subcommands and groups can have options")
+		}
+		if option.Required && optional {
+			l.errorf(path+".required", "required options must be before optional options")
+		}
+		optional = optional || !option.Required
+		lintOption(l, path, option)
+	}
+}
+
+func lintOption(l *linter, path string, option *discordgo.ApplicationCommandOption) {
+	numeric := option.Type == discordgo.ApplicationCommandOptionInteger || option.Type == discordgo.ApplicationCommandOptionNumber
+	text := option.Type == discordgo.ApplicationCommandOptionString
+
+	switch option.Type {
+	case discordgo.ApplicationCommandOptionString, discordgo.ApplicationCommandOptionInteger, discordgo.ApplicationCommandOptionBoolean,
+		discordgo.ApplicationCommandOptionUser, discordgo.ApplicationCommandOptionChannel, discordgo.ApplicationCommandOptionRole,
+		discordgo.ApplicationCommandOptionMentionable, discordgo.ApplicationCommandOptionNumber, discordgo.ApplicationCommandOptionAttachment:
+	default:
+		l.errorf(path+".type", "unknown type %d", option.Type)
+	}
+
+	if len(option.Choices) > 0 {
+		if !text && !numeric {
+			l.errorf(path+".choices", "only string, integer and number options can have choices")
+		}
+		if option.Autocomplete {
+			l.errorf(path+".autocomplete", "options with choices cannot have autocomplete")
+		}
+		if len(option.Choices) > maxChoices {
+			l.errorf(path+".choices", "has %d choices, at most %d are allowed", len(option.Choices), maxChoices)
+		}
+	}
+	for i, choice := range option.Choices {
+		path := fmt.Sprintf("%s.choices[%d]", path, i)
+		if choice == nil {
+			l.errorf(path, "must not be null")
+			continue
+		}
+		if n := utf8.RuneCountInString(choice.Name); n < 1 || n > maxChoiceLength {
+			l.errorf(path+".name", "must be 1-%d characters, is %d", maxChoiceLength, n)
+		}
+		lintLocalizations(l, path+".name_localizations", choice.NameLocalizations, 1, maxChoiceLength)
+		if !text && !numeric {
+			continue
+		}
+
+		switch value := choice.Value.(type) {
+		case string:
+			if !text {
+				l.errorf(path+".value", "must be a number for %s options", optionTypeName(option.Type))
+			} else if n := utf8.RuneCountInString(value); n < 1 || n > maxChoiceLength {
+				l.errorf(path+".value", "must be 1-%d characters, is %d", maxChoiceLength, n)
+			}
+		case float64:
+			if !numeric {
+				l.errorf(path+".value", "must be a string for string options")
+			} else if option.Type == discordgo.ApplicationCommandOptionInteger && value != float64(int64(value)) {
+				l.errorf(path+".value", "must be an integer")
+			}
+		default:
+			l.errorf(path+".value", "must be a string or number")
+		}
+	}
+
+	if option.Autocomplete && !text && !numeric {
+		l.errorf(path+".autocomplete", "only string, integer and number options can have autocomplete")
+	}
+	if (option.MinValue != nil || option.MaxValue != 0) && !numeric {
+		l.errorf(path, "only integer and number options can have min_value and max_value")
+	}
+	if option.MinValue != nil && option.MaxValue != 0 && *option.MinValue > option.MaxValue {
+		l.errorf(path+".min_value", "must not be more than max_value")
+	}
+	if option.MinLength != nil || option.MaxLength != 0 {
+		if !text {
+			l.errorf(path, "only string options can have min_length and max_length")
+		}
+		if option.MinLength != nil && (*option.MinLength < 0 || *option.MinLength > maxOptionLength) {
+			l.errorf(path+".min_length", "must be 0-%d", maxOptionLength)
+		}
+		if option.MaxLength < 0 || option.MaxLength > maxOptionLength {
+			l.errorf(path+".max_length", "must be 1-%d", maxOptionLength)
+		}
+		if option.MinLength != nil && option.MaxLength != 0 && *option.MinLength > option.MaxLength {
+			l.errorf(path+".min_length", "must not be more than max_length")
+		}
+	}
+	if len(option.ChannelTypes) > 0 && option.Type != discordgo.ApplicationCommandOptionChannel {
+		l.errorf(path+".channel_types", "only channel options can have channel_types")
+	}
+}
+
+func optionTypeName(t discordgo.ApplicationCommandOptionType) string {
+	if t == discordgo.ApplicationCommandOptionNumber {
+		return "number"
+	}
+	return "integer"
+}
+
+// commandLength returns the combined length of the command's names, descriptions and choices, counting the longest localization of each.
+func commandLength(command *discordgo.ApplicationCommand) int {
+	n := longest(command.Name, localizations(command.NameLocalizations)) +
+		longest(command.Description, localizations(command.DescriptionLocalizations))
+	walkOptions(command.Options, func(option *discordgo.ApplicationCommandOption) {
+		n += longest(option.Name, option.NameLocalizations) + longest(option.Description, option.DescriptionLocalizations)
+		for _, choice := range option.Choices {
+			if choice == nil {
+				continue
+			}
+			n += longest(choice.Name, choice.NameLocalizations)
+			if value, ok := choice.Value.(string); ok {
+				n += utf8.RuneCountInString(value)
+			}
+		}
+	})
+	return n
+}
+
+func longest(value string, localized map[discordgo.Locale]string) int {
+	n := utf8.RuneCountInString(value)
+	for _, value := range localized {
+		n = max(n, utf8.RuneCountInString(value))
+	}
+	return n
+}
+
+// walkOptions calls f for every option, including the options of subcommands and groups.
+func walkOptions(options []*discordgo.ApplicationCommandOption, f func(option *discordgo.ApplicationCommandOption)) {
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		f(option)
+		walkOptions(option.Options, f)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// command is a powergridctl subcommand, which parses its own flags.
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
+
+var commands = []command{
+	{"lint", "check Command manifests against Discord's rules", lint},
+	{"diff", "compare Command manifests with the commands registered on Discord", diff},
+	{"export", "write Command manifests for the commands registered on Discord", export},
+	{"generate", "write Command manifests for the marked structs of a Go package", generate},
+	{"simulate", "show how an interaction would be routed by manifests", simulate},
+	{"replay", "replay a captured interaction against a service", replay},
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: powergridctl <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+		err := cmd.run(os.Args[2:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", cmd.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	usage()
+	os.Exit(2)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"io"
+	"io/fs"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// filesFlag is a flag which can be repeated, e.g. -f commands/ -f routes.yaml
+type filesFlag []string
+
+func (f *filesFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *filesFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// manifests are the objects read from YAML or JSON files, which powergridctl uses instead of a cluster.
+// Objects of other kinds are ignored.
+type manifests struct {
+	commands        []*powergridv10.Command
+	componentRoutes []*powergridv10.ComponentRoute
+	accessPolicies  []*powergridv10.AccessPolicy
+	referenceGrants []*powergridv10.ReferenceGrant
+	configMaps      []*corev1.ConfigMap
+	// sources maps objects to the file they were read from, for messages.
+	sources map[metav1.Object]string
+}
+
+// readManifests reads the objects in the files, and the .yaml, .yml and .json files in directories. - is stdin.
+// Objects without a namespace are put in the default namespace, as kubectl apply would.
+func readManifests(paths []string, defaultNamespace string) (*manifests, error) {
+	m := &manifests{sources: make(map[metav1.Object]string)}
+	for _, path := range paths {
+		if path == "-" {
+			err := m.read("<stdin>", os.Stdin, defaultNamespace)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// files given directly are read whatever their extension
+			if file != path && !slices.Contains([]string{".yaml", ".yml", ".json"}, filepath.Ext(file)) {
+				return nil
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return m.read(file, f, defaultNamespace)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *manifests) read(source string, r io.Reader, defaultNamespace string) error {
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+		err = m.add(source, raw, defaultNamespace)
+		if err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+	}
+}
+
+func (m *manifests) add(source string, raw json.RawMessage, defaultNamespace string) error {
+	// empty documents, e.g. after a trailing ---
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	typeMeta := &metav1.TypeMeta{}
+	err := json.Unmarshal(raw, typeMeta)
+	if err != nil {
+		return err
+	}
+
+	var object metav1.Object
+	switch {
+	case typeMeta.Kind == "List" || strings.HasSuffix(typeMeta.Kind, "List"):
+		list := &struct {
+			Items []json.RawMessage `json:"items"`
+		}{}
+		err = json.Unmarshal(raw, list)
+		if err != nil {
+			return err
+		}
+		for _, item := range list.Items {
+			err = m.add(source, item, defaultNamespace)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case typeMeta.APIVersion == powergridv10.SchemeGroupVersion.String() && typeMeta.Kind == "Command":
+		cmd := &powergridv10.Command{}
+		err = json.Unmarshal(raw, cmd)
+		m.commands = append(m.commands, cmd)
+		object = cmd
+	case typeMeta.APIVersion == powergridv10.SchemeGroupVersion.String() && typeMeta.Kind == "ComponentRoute":
+		route := &powergridv10.ComponentRoute{}
+		err = json.Unmarshal(raw, route)
+		m.componentRoutes = append(m.componentRoutes, route)
+		object = route
+	case typeMeta.APIVersion == powergridv10.SchemeGroupVersion.String() && typeMeta.Kind == "AccessPolicy":
+		policy := &powergridv10.AccessPolicy{}
+		err = json.Unmarshal(raw, policy)
+		m.accessPolicies = append(m.accessPolicies, policy)
+		object = policy
+	case typeMeta.APIVersion == powergridv10.SchemeGroupVersion.String() && typeMeta.Kind == "ReferenceGrant":
+		grant := &powergridv10.ReferenceGrant{}
+		err = json.Unmarshal(raw, grant)
+		m.referenceGrants = append(m.referenceGrants, grant)
+		object = grant
+	case typeMeta.APIVersion == "v1" && typeMeta.Kind == "ConfigMap":
+		configMap := &corev1.ConfigMap{}
+		err = json.Unmarshal(raw, configMap)
+		m.configMaps = append(m.configMaps, configMap)
+		object = configMap
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", typeMeta.Kind, err)
+	}
+
+	if object.GetNamespace() == "" {
+		object.SetNamespace(defaultNamespace)
+	}
+	m.sources[object] = source
+	return nil
+}
+
+// describe returns the source file and namespace/name of the object, for messages.
+func (m *manifests) describe(object metav1.Object) string {
+	return m.sources[object] + ": " + object.GetNamespace() + "/" + object.GetName()
+}
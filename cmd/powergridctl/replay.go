@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/sportshead/powergrid/internal/coordinator/capture"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// replay sends a captured interaction to a service, signed with a test key instead of Discord's.
+// Captures are read from a CAPTURE_FILE, or the output of the admin API's /captures.
+func replay(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	file := flags.String("captures", "-", "file of captures as JSON lines or a JSON array, - for stdin")
+	id := flags.String("id", "", "ID of the interaction to replay, defaults to the last capture")
+	target := flags.String("url", "", "URL of the service to send the interaction to, e.g. http://localhost:8080/")
+	key := flags.String("key", "", "hex encoded ed25519 seed to sign the interaction with, a new key is generated if unset")
+	_ = flags.Parse(args)
+
+	if *target == "" {
+		return errors.New("-url is required")
+	}
+
+	captures, err := readCaptures(*file)
+	if err != nil {
+		return err
+	}
+	c, err := findCapture(captures, *id)
+	if err != nil {
+		return err
+	}
+
+	var privateKey ed25519.PrivateKey
+	if *key == "" {
+		var publicKey ed25519.PublicKey
+		publicKey, privateKey, err = ed25519.GenerateKey(nil)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "signing with generated key, public key %s\n", hex.EncodeToString(publicKey))
+	} else {
+		seed, err := hex.DecodeString(*key)
+		if err != nil {
+			return fmt.Errorf("failed to decode key: %w", err)
+		}
+		if len(seed) != ed25519.SeedSize {
+			return fmt.Errorf("key must be %d bytes", ed25519.SeedSize)
+		}
+		privateKey = ed25519.NewKeyFromSeed(seed)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	body := []byte(c.Interaction)
+	signature := ed25519.Sign(privateKey, append([]byte(timestamp), body...))
+
+	req, err := http.NewRequest(http.MethodPost, *target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", utils.MimeTypeJSON)
+	req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(signature))
+	req.Header.Set("X-Signature-Timestamp", timestamp)
+
+	fmt.Fprintf(os.Stderr, "replaying interaction %s for %s, originally answered with status %d\n", c.ID, c.Route, c.Status)
+	start := time.Now()
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	fmt.Fprintf(os.Stderr, "%s in %s\n", res.Status, time.Since(start).Round(time.Millisecond))
+	_, err = io.Copy(os.Stdout, res.Body)
+	return err
+}
+
+// readCaptures decodes a stream of captures, each of which may be a single capture or an array of them.
+func readCaptures(file string) ([]*capture.Capture, error) {
+	r := io.Reader(os.Stdin)
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var captures []*capture.Capture
+	decoder := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode captures: %w", err)
+		}
+
+		raw = bytes.TrimSpace(raw)
+		if len(raw) > 0 && raw[0] == '[' {
+			var list []*capture.Capture
+			err = json.Unmarshal(raw, &list)
+			captures = append(captures, list...)
+		} else {
+			c := &capture.Capture{}
+			err = json.Unmarshal(raw, c)
+			captures = append(captures, c)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode captures: %w", err)
+		}
+	}
+	return captures, nil
+}
+
+func findCapture(captures []*capture.Capture, id string) (*capture.Capture, error) {
+	if len(captures) == 0 {
+		return nil, errors.New("no captures found")
+	}
+	if id == "" {
+		return captures[len(captures)-1], nil
+	}
+	for i := len(captures) - 1; i >= 0; i-- {
+		if captures[i].ID == id {
+			return captures[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no capture with id %s", id)
+}
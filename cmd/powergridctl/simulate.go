@@ -0,0 +1,323 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/appcommand"
+	"github.com/sportshead/powergrid/internal/coordinator/autocomplete"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	"github.com/sportshead/powergrid/internal/coordinator/static"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"io"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"os"
+	"slices"
+	"strings"
+)
+
+// simulation prints the steps the coordinator takes to route an interaction, using the objects in manifests instead of a cluster.
+// Services, activation and rate limit buckets are not simulated.
+type simulation struct {
+	m           *manifests
+	namespace   string
+	body        []byte
+	interaction *discordgo.Interaction
+}
+
+// simulate shows how an interaction would be routed by Commands and ComponentRoutes, e.g. to check guild routes and access policies.
+func simulate(args []string) error {
+	flags := flag.NewFlagSet("simulate", flag.ExitOnError)
+	var files filesFlag
+	flags.Var(&files, "f", "manifest file or directory of Commands, ComponentRoutes, AccessPolicies, ReferenceGrants and ConfigMaps, can be repeated")
+	namespace := flags.String("namespace", "default", "namespace of the coordinator, and of objects without one")
+	file := flags.String("interaction", "", "file of the interaction as JSON, - for stdin")
+	captures := flags.String("captures", "", "file of captures to read the interaction from instead, as for replay")
+	id := flags.String("id", "", "ID of the captured interaction, defaults to the last capture")
+	_ = flags.Parse(args)
+
+	if len(files) == 0 {
+		return errors.New("-f is required")
+	}
+	m, err := readManifests(files, *namespace)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	switch {
+	case *captures != "":
+		c, err := readCaptures(*captures)
+		if err != nil {
+			return err
+		}
+		capture, err := findCapture(c, *id)
+		if err != nil {
+			return err
+		}
+		body = []byte(capture.Interaction)
+	case *file == "-":
+		body, err = io.ReadAll(os.Stdin)
+	case *file != "":
+		body, err = os.ReadFile(*file)
+	default:
+		return errors.New("-interaction or -captures is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	s := &simulation{m: m, namespace: *namespace, body: body, interaction: &discordgo.Interaction{}}
+	err = s.interaction.UnmarshalJSON(body)
+	if err != nil {
+		return fmt.Errorf("failed to parse interaction: %w", err)
+	}
+
+	switch s.interaction.Type {
+	case discordgo.InteractionPing:
+		s.step("interaction", "ping")
+		s.step("result", "answered with a pong by the coordinator")
+	case discordgo.InteractionApplicationCommand, discordgo.InteractionApplicationCommandAutocomplete:
+		s.command()
+	case discordgo.InteractionMessageComponent:
+		s.component(s.interaction.MessageComponentData().CustomID)
+	case discordgo.InteractionModalSubmit:
+		s.component(s.interaction.ModalSubmitData().CustomID)
+	default:
+		return fmt.Errorf("unknown interaction type %d", s.interaction.Type)
+	}
+	return nil
+}
+
+func (s *simulation) step(name string, format string, args ...interface{}) {
+	fmt.Printf("%-12s %s\n", name+":", fmt.Sprintf(format, args...))
+}
+
+func (s *simulation) command() {
+	data := s.interaction.ApplicationCommandData()
+	s.step("interaction", "%s for command %q, user %s, guild %q, channel %s",
+		s.interaction.Type, data.Name, routing.UserID(s.interaction), s.interaction.GuildID, s.interaction.ChannelID)
+
+	var matches []*powergridv10.Command
+	for _, cmd := range s.m.commands {
+		command, err := appcommand.Parse(cmd, nil)
+		if err == nil && command.Name == data.Name {
+			matches = append(matches, cmd)
+		}
+	}
+	if len(matches) != 1 {
+		s.step("command", "%d Command objects match the name", len(matches))
+		s.step("result", "answered with the missing handler message")
+		return
+	}
+	cmd := matches[0]
+	s.step("command", s.m.describe(cmd))
+
+	if !s.allowed(cmd.Namespace, cmd.Spec.AccessPolicies) || !s.entitled(cmd.Spec.RequiredSKUs) {
+		return
+	}
+	if s.interaction.Type == discordgo.InteractionApplicationCommand && len(cmd.Spec.RateLimits) > 0 {
+		s.step("rate limits", "%d limits, not simulated", len(cmd.Spec.RateLimits))
+	}
+	if cmd.Spec.Audit != nil && s.interaction.Type == discordgo.InteractionApplicationCommand {
+		s.step("audit", "recorded, sinks: %d", len(cmd.Spec.Audit.Sinks))
+	}
+	if cmd.Spec.AutocompleteCache != nil && s.interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		if _, ok := autocomplete.Key(cmd, s.interaction); ok {
+			s.step("cache", "answered from the cache if the response is cached, otherwise cached for %s unless Cache-Control overrides it", cmd.Spec.AutocompleteCache.TTL.Duration)
+		} else {
+			s.step("cache", "no option is focused, not cached")
+		}
+	}
+
+	if cmd.Spec.Response != nil {
+		s.staticResponse(cmd.Spec.Response)
+		return
+	}
+
+	service := s.guildService(cmd.Namespace, cmd.Spec.GuildRoutes)
+	if service == "" && cmd.Spec.External != nil {
+		s.step("result", "forwarded to the external endpoint %s over http", cmd.Spec.External.URL)
+		return
+	}
+	if service == "" {
+		service = s.backend(cmd)
+	}
+	if !s.service(cmd.Namespace, service, cmd.Spec.Port) {
+		return
+	}
+	s.step("path", routing.CommandPath(cmd.Spec.Path, data.Name))
+
+	transport := cmd.Spec.Transport
+	if transport == "" {
+		transport = "grpc if the port has an appProtocol of grpc, otherwise http"
+	}
+	s.step("transport", transport)
+	if cmd.Spec.ShouldSendDeferred && s.interaction.Type != discordgo.InteractionApplicationCommandAutocomplete {
+		s.step("result", "answered with a deferred response, then forwarded to the service")
+		return
+	}
+	s.step("result", "forwarded to the service, which responds to the interaction")
+}
+
+// staticResponse prints the inline response the coordinator answers with.
+func (s *simulation) staticResponse(response *powergridv10.StaticResponse) {
+	if s.interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		s.step("result", "answered with no choices by the coordinator")
+		return
+	}
+	res, err := static.Render(response, s.interaction)
+	if err != nil {
+		s.step("response", "failed to render: %v", err)
+		s.step("result", "answered with the render failed message")
+		return
+	}
+	s.step("response", "%s", res)
+	s.step("result", "answered with the response by the coordinator")
+}
+
+func (s *simulation) component(customID string) {
+	prefix := powergrid.ComponentPrefix(customID)
+	s.step("interaction", "%s for custom_id %q, user %s, guild %q, channel %s",
+		s.interaction.Type, customID, routing.UserID(s.interaction), s.interaction.GuildID, s.interaction.ChannelID)
+
+	var matches []*powergridv10.ComponentRoute
+	for _, route := range s.m.componentRoutes {
+		if route.Spec.Prefix == prefix {
+			matches = append(matches, route)
+		}
+	}
+	if len(matches) > 1 {
+		s.step("route", "%d ComponentRoutes match the prefix %q", len(matches), prefix)
+		s.step("result", "answered with the missing service message")
+		return
+	}
+	if len(matches) == 0 {
+		s.step("route", "no ComponentRoute matches the prefix %q, which is a service in the coordinator's namespace", prefix)
+		if s.service(s.namespace, prefix, nil) {
+			s.step("result", "forwarded to the service, which responds to the interaction")
+		}
+		return
+	}
+	route := matches[0]
+	s.step("route", s.m.describe(route))
+
+	service := s.guildService(route.Namespace, route.Spec.GuildRoutes)
+	if !s.allowed(route.Namespace, route.Spec.AccessPolicies) || !s.entitled(route.Spec.RequiredSKUs) {
+		return
+	}
+	if service == "" && route.Spec.External != nil {
+		s.step("result", "forwarded to the external endpoint %s over http", route.Spec.External.URL)
+		return
+	}
+	if service == "" {
+		service = route.Spec.ServiceName
+	}
+	if !s.service(route.Namespace, service, route.Spec.Port) {
+		return
+	}
+	s.step("path", routing.ComponentPath(route.Spec.Path, prefix))
+	s.step("result", "forwarded to the service, which responds to the interaction")
+}
+
+// allowed evaluates the access policies as checkAccess does. Policies missing from the manifests deny the interaction.
+func (s *simulation) allowed(namespace string, policies []string) bool {
+	for _, name := range policies {
+		i := slices.IndexFunc(s.m.accessPolicies, func(policy *powergridv10.AccessPolicy) bool {
+			return policy.Namespace == namespace && policy.Name == name
+		})
+		if i == -1 {
+			s.step("access", "AccessPolicy %s/%s is not in the manifests", namespace, name)
+			s.step("result", "answered with the access denied message")
+			return false
+		}
+		if !routing.AllowedByPolicy(s.m.accessPolicies[i], s.interaction) {
+			s.step("access", "denied by %s", s.m.describe(s.m.accessPolicies[i]))
+			s.step("result", "answered with the access denied message")
+			return false
+		}
+		s.step("access", "allowed by %s", s.m.describe(s.m.accessPolicies[i]))
+	}
+	return true
+}
+
+func (s *simulation) entitled(skus []string) bool {
+	if len(skus) == 0 {
+		return true
+	}
+	ok, err := routing.HasEntitlement(s.body, skus)
+	if err != nil || !ok {
+		s.step("entitlement", "none of the SKUs %s", strings.Join(skus, ", "))
+		s.step("result", "answered with premium buttons for the SKUs")
+		return false
+	}
+	s.step("entitlement", "entitled to one of the SKUs %s", strings.Join(skus, ", "))
+	return true
+}
+
+// guildService returns the service of the first guild route matching the guild, reading guild IDs from ConfigMaps in the manifests.
+func (s *simulation) guildService(namespace string, routes []powergridv10.GuildRoute) string {
+	guildID := s.interaction.GuildID
+	if guildID == "" {
+		return ""
+	}
+	for i, route := range routes {
+		ids := route.GuildIDs
+		if route.GuildIDsFrom != nil {
+			j := slices.IndexFunc(s.m.configMaps, func(configMap *corev1.ConfigMap) bool {
+				return configMap.Namespace == namespace && configMap.Name == route.GuildIDsFrom.Name
+			})
+			if j == -1 {
+				s.step("guild route", "route %d reads guild IDs from ConfigMap %s/%s, which is not in the manifests", i, namespace, route.GuildIDsFrom.Name)
+			} else {
+				ids = append(slices.Clone(ids), routing.GuildIDs(s.m.configMaps[j].Data[route.GuildIDsFrom.Key])...)
+			}
+		}
+		if slices.Contains(ids, guildID) {
+			s.step("guild route", "route %d matches guild %s", i, guildID)
+			return route.ServiceName
+		}
+	}
+	return ""
+}
+
+// backend picks the service of the command as selectService does, which is random if the backends aren't sticky.
+func (s *simulation) backend(cmd *powergridv10.Command) string {
+	service := routing.SelectService(cmd, s.interaction)
+	if len(cmd.Spec.Backends) == 0 {
+		return service
+	}
+
+	weights := make([]string, 0, len(cmd.Spec.Backends))
+	for _, backend := range cmd.Spec.Backends {
+		weights = append(weights, fmt.Sprintf("%s=%d", backend.ServiceName, backend.Weight))
+	}
+	if cmd.Spec.StickyBy == "" {
+		s.step("backend", "%s, picked at random by weight from %s", service, strings.Join(weights, ", "))
+	} else {
+		s.step("backend", "%s, picked by %s ID from %s", service, cmd.Spec.StickyBy, strings.Join(weights, ", "))
+	}
+	return service
+}
+
+// service prints the service a reference resolves to, returning false if the reference is not allowed by a ReferenceGrant.
+func (s *simulation) service(from string, ref string, port *intstr.IntOrString) bool {
+	serviceNamespace, serviceName := routing.ParseServiceRef(from, ref)
+	if serviceNamespace != from && !slices.ContainsFunc(s.m.referenceGrants, func(grant *powergridv10.ReferenceGrant) bool {
+		return grant.Namespace == serviceNamespace && routing.GrantAllows(grant, from, serviceName)
+	}) {
+		s.step("service", "%s/%s is not allowed by a ReferenceGrant in the manifests", serviceNamespace, serviceName)
+		s.step("result", "answered with the missing service message")
+		return false
+	}
+
+	portName := "http, otherwise the first port"
+	if port != nil {
+		portName = port.String()
+	}
+	s.step("service", "%s/%s, port %s", serviceNamespace, serviceName, portName)
+	return true
+}
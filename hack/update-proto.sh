@@ -0,0 +1,18 @@
+#!/usr/bin/env bash
+
+# Requires protoc, protoc-gen-go and protoc-gen-go-grpc on the PATH.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+PROTOC=${PROTOC:-protoc}
+PKG_ROOT=$(realpath "$(dirname ${BASH_SOURCE[0]})/..")
+
+cd $PKG_ROOT
+
+$PROTOC \
+  --proto_path=. \
+  --go_out=paths=source_relative:. \
+  --go-grpc_out=paths=source_relative:. \
+  pkg/proto/interaction/v1/interaction.proto
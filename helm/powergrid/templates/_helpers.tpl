@@ -60,3 +60,55 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+RBAC rules for the resources read in watched namespaces
+*/}}
+{{- define "powergrid.watchRules" -}}
+- apiGroups:
+    - powergrid.sportshead.dev
+  resources:
+    - commands
+    - roleconnectionmetadatarecords
+    - eventsubscriptions
+    - componentroutes
+    - accesspolicies
+    - referencegrants
+  verbs: ["get", "watch", "list"]
+- apiGroups:
+    - powergrid.sportshead.dev
+  resources:
+    - commands/status
+  verbs: ["patch"]
+- apiGroups:
+    - ""
+  resources:
+    - services
+    - configmaps
+  verbs: ["get", "watch", "list"]
+- apiGroups:
+    - ""
+  resources:
+    - secrets
+  verbs: ["get"]
+- apiGroups:
+    - discovery.k8s.io
+  resources:
+    - endpointslices
+  verbs: ["get", "watch", "list"]
+- apiGroups:
+    - apps
+  resources:
+    - deployments
+  verbs: ["get", "patch"]
+- apiGroups:
+    - apps
+  resources:
+    - deployments/scale
+  verbs: ["get", "update"]
+- apiGroups:
+    - ""
+  resources:
+    - events
+  verbs: ["create", "patch"]
+{{- end }}
@@ -0,0 +1,41 @@
+// Package appcommand converts Command objects to Discord application commands.
+// It is shared by the coordinator's sync and powergridctl, so that powergridctl diff shows what a sync would change.
+package appcommand
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"reflect"
+)
+
+// Parse returns the application command of the Command object, with the defaults Discord sets filled in.
+// If registered is not nil, its ID, application, guild and version are copied, so the result can be compared with Equal.
+func Parse(cmd *powergridv10.Command, registered *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	command := &discordgo.ApplicationCommand{}
+	if registered != nil {
+		command.ID = registered.ID
+		command.ApplicationID = registered.ApplicationID
+		command.GuildID = registered.GuildID
+		command.Version = registered.Version
+	}
+	err := json.Unmarshal(cmd.Spec.Command.Raw, command)
+	if err != nil {
+		return nil, err
+	}
+
+	// set defaults
+	if command.Type == 0 {
+		command.Type = discordgo.ChatApplicationCommand
+	}
+	if command.NSFW == nil {
+		command.NSFW = utils.Ptr(false)
+	}
+	return command, nil
+}
+
+// Equal checks whether the registered command already matches the parsed command, in which case it is not edited.
+func Equal(registered *discordgo.ApplicationCommand, parsed *discordgo.ApplicationCommand) bool {
+	return registered.Type == parsed.Type && reflect.DeepEqual(registered, parsed)
+}
@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"strings"
+	"time"
+)
+
+// Masked replaces the values of masked options in audit records.
+const Masked = "[masked]"
+
+// Outcomes of audited interactions.
+const (
+	OutcomeOK            = "ok"
+	OutcomeFailed        = "failed"
+	OutcomeUpstreamError = "upstream_error"
+	OutcomeDenied        = "denied"
+	OutcomeRateLimited   = "rate_limited"
+	OutcomeUnknown       = "unknown"
+)
+
+// ForwardOutcome returns the outcome of an interaction forwarded to a service, from the result counted by the metrics package.
+func ForwardOutcome(result string) string {
+	switch result {
+	case metrics.ResultOK:
+		return OutcomeOK
+	case metrics.ResultUpstreamError:
+		return OutcomeUpstreamError
+	case metrics.ResultFailed:
+		return OutcomeFailed
+	}
+	return OutcomeUnknown
+}
+
+// Record is a single use of an audited command.
+type Record struct {
+	Time          time.Time `json:"time"`
+	InteractionID string    `json:"interactionID"`
+	// Command is the full name of the command, including subcommand groups and subcommands, e.g. "mod ban".
+	Command string `json:"command"`
+	// Object is the namespace/name of the Command object.
+	Object string `json:"object"`
+
+	User      User   `json:"user"`
+	GuildID   string `json:"guildID,omitempty"`
+	ChannelID string `json:"channelID,omitempty"`
+
+	// Options are the option values by name, with users, roles, channels and attachments resolved.
+	Options map[string]interface{} `json:"options"`
+
+	// Outcome is one of the Outcome constants.
+	Outcome string `json:"outcome"`
+}
+
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// Resolved is a user, role, channel or attachment option value.
+type Resolved struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// NewRecord builds the audit record of an application command interaction, with an unknown outcome.
+func NewRecord(cmd *powergridv10.Command, interaction *discordgo.Interaction) *Record {
+	data := interaction.ApplicationCommandData()
+	record := &Record{
+		Time:          time.Now(),
+		InteractionID: interaction.ID,
+		Object:        cmd.Namespace + "/" + cmd.Name,
+		GuildID:       interaction.GuildID,
+		ChannelID:     interaction.ChannelID,
+		Options:       make(map[string]interface{}),
+		Outcome:       OutcomeUnknown,
+	}
+
+	user := interaction.User
+	if interaction.Member != nil && interaction.Member.User != nil {
+		user = interaction.Member.User
+	}
+	if user != nil {
+		record.User = User{ID: user.ID, Username: user.Username}
+	}
+
+	masked := make(map[string]bool)
+	if cmd.Spec.Audit != nil {
+		for _, name := range cmd.Spec.Audit.MaskedOptions {
+			masked[name] = true
+		}
+	}
+
+	name, options := powergrid.FullCommand(data)
+	record.Command = strings.Join(name, " ")
+
+	for _, option := range options {
+		if masked[option.Name] {
+			record.Options[option.Name] = Masked
+			continue
+		}
+		record.Options[option.Name] = resolve(data.Resolved, option)
+	}
+	return record
+}
+
+// resolve returns the value of the option, with the names of users, roles, channels and attachments.
+func resolve(resolved *discordgo.ApplicationCommandInteractionDataResolved, option *discordgo.ApplicationCommandInteractionDataOption) interface{} {
+	id, _ := option.Value.(string)
+	if resolved == nil || id == "" {
+		return option.Value
+	}
+
+	switch option.Type {
+	case discordgo.ApplicationCommandOptionUser:
+		if user, ok := resolved.Users[id]; ok {
+			return Resolved{ID: id, Name: user.Username}
+		}
+	case discordgo.ApplicationCommandOptionRole:
+		if role, ok := resolved.Roles[id]; ok {
+			return Resolved{ID: id, Name: role.Name}
+		}
+	case discordgo.ApplicationCommandOptionChannel:
+		if channel, ok := resolved.Channels[id]; ok {
+			return Resolved{ID: id, Name: channel.Name}
+		}
+	case discordgo.ApplicationCommandOptionMentionable:
+		if user, ok := resolved.Users[id]; ok {
+			return Resolved{ID: id, Name: user.Username}
+		}
+		if role, ok := resolved.Roles[id]; ok {
+			return Resolved{ID: id, Name: role.Name}
+		}
+	case discordgo.ApplicationCommandOptionAttachment:
+		if attachment, ok := resolved.Attachments[id]; ok {
+			return Resolved{ID: id, Name: attachment.Filename}
+		}
+	}
+	return option.Value
+}
@@ -0,0 +1,193 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// attempts is the number of times a record is sent to a webhook or HTTP sink before it is dropped.
+	attempts = 3
+	// attemptTimeout is how long each attempt can take.
+	attemptTimeout = 10 * time.Second
+	// maxWebhookContent is the maximum length of a Discord message.
+	maxWebhookContent = 2000
+)
+
+var fileMutex sync.Mutex
+
+// ErrSinkNotHTTPS is returned for webhook and HTTP sinks whose URL is not https.
+var ErrSinkNotHTTPS = errors.New("audit sink url is not https")
+
+// Write sends the record to every sink, logging failures. namespace is the namespace Secrets are read from.
+// Webhook and HTTP sinks are sent through client, and are retried in the background after the first attempt.
+func Write(log *slog.Logger, client *http.Client, namespace string, sinks []powergridv10.AuditSink, record *Record) {
+	log = log.With(slog.String("command", record.Command), slog.String("outcome", record.Outcome))
+	for i, sink := range sinks {
+		log := log.With(slog.Int("sink", i))
+		switch {
+		case sink.File != "":
+			logResult(log, record, writeFile(namespace, sink.File, record))
+		case sink.DiscordWebhook != nil:
+			retry(log, record, 1, func(ctx context.Context) error {
+				webhookURL, err := kubernetes.GetSecretKeyRef(ctx, namespace, sink.DiscordWebhook)
+				if err != nil {
+					return err
+				}
+				return post(ctx, client, webhookURL, nil, webhookMessage(record))
+			})
+		case sink.HTTP != nil:
+			retry(log, record, 1, func(ctx context.Context) error {
+				header := http.Header{}
+				for _, h := range sink.HTTP.Headers {
+					value, err := kubernetes.GetHeaderValue(ctx, namespace, h)
+					if err != nil {
+						return err
+					}
+					header.Set(h.Name, value)
+				}
+				return post(ctx, client, sink.HTTP.URL, header, record)
+			})
+		default:
+			logResult(log, record, errors.New("audit sink has no destination"))
+		}
+	}
+}
+
+func logResult(log *slog.Logger, record *Record, err error) {
+	if err != nil {
+		log.Error("failed to write audit record", utils.Tag("audit_write_failed"), utils.Error(err), slog.String("record", utils.TryMarshal(record)))
+		return
+	}
+	log.Debug("wrote audit record", utils.Tag("audit_written"))
+}
+
+// writeFile appends the record to the file in env.AuditDir, prefixed with the namespace so Commands in different namespaces
+// can't write to each other's files, and syncs it to disk.
+func writeFile(namespace string, name string, record *Record) error {
+	if name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid audit file name %s", name)
+	}
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	fileMutex.Lock()
+	defer fileMutex.Unlock()
+
+	err = os.MkdirAll(env.AuditDir, 0o700)
+	if err != nil {
+		return err
+	}
+	// namespaces can't contain underscores, so the prefix can't be spoofed by another namespace
+	f, err := os.OpenFile(filepath.Join(env.AuditDir, namespace+"_"+name), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(b, '\n'))
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// retry calls f, and schedules the next attempt after a backoff if it fails, so only the first attempt blocks the caller.
+func retry(log *slog.Logger, record *Record, attempt int, f func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), attemptTimeout)
+	err := f(ctx)
+	cancel()
+	if err == nil || attempt >= attempts || errors.Is(err, ErrSinkNotHTTPS) {
+		logResult(log, record, err)
+		return
+	}
+
+	log.Warn("retrying audit record", utils.Tag("audit_write_retry"), utils.Error(err), slog.Int("attempt", attempt))
+	time.AfterFunc(time.Duration(attempt)*time.Second, func() {
+		retry(log, record, attempt+1, f)
+	})
+}
+
+func post(ctx context.Context, client *http.Client, rawURL string, header http.Header, v interface{}) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return ErrSinkNotHTTPS
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	if header != nil {
+		req.Header = header
+	}
+	req.Header.Set("Content-Type", utils.MimeTypeJSON)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("audit sink returned %s", res.Status)
+	}
+	return nil
+}
+
+// webhookMessage formats the record as a Discord message, without pinging anyone.
+func webhookMessage(record *Record) interface{} {
+	var b strings.Builder
+	fmt.Fprintf(&b, "`/%s` used by <@%s> (%s)", record.Command, record.User.ID, record.User.Username)
+	if record.ChannelID != "" {
+		fmt.Fprintf(&b, " in <#%s>", record.ChannelID)
+	}
+	fmt.Fprintf(&b, ": **%s**", record.Outcome)
+
+	names := make([]string, 0, len(record.Options))
+	for name := range record.Options {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		value := record.Options[name]
+		if resolved, ok := value.(Resolved); ok {
+			value = resolved.Name + " (" + resolved.ID + ")"
+		}
+		fmt.Fprintf(&b, "\n`%s`: %v", name, value)
+	}
+
+	content := b.String()
+	if len(content) > maxWebhookContent {
+		content = strings.ToValidUTF8(content[:maxWebhookContent-3], "") + "..."
+	}
+	return map[string]interface{}{
+		"content":          content,
+		"allowed_mentions": map[string]interface{}{"parse": []string{}},
+	}
+}
@@ -0,0 +1,78 @@
+// Package autocomplete caches the responses of services to autocomplete interactions, which are sent on every keystroke.
+package autocomplete
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often expired responses are removed from a Cache.
+const sweepInterval = time.Minute
+
+type entry struct {
+	response []byte
+	expires  time.Time
+}
+
+// Cache keeps responses in memory until they expire. Responses are not shared between replicas.
+type Cache struct {
+	mu        sync.Mutex
+	entries   map[string]*entry
+	size      int
+	lastSweep time.Time
+}
+
+// NewCache returns a Cache which holds at most size responses.
+func NewCache(size int) *Cache {
+	return &Cache{
+		entries:   make(map[string]*entry),
+		size:      size,
+		lastSweep: time.Now(),
+	}
+}
+
+// Get returns the response cached for the key, if it hasn't expired.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+	return e.response, true
+}
+
+// Set caches the response for the key until the TTL passes. If the cache is full, the response which expires first is removed.
+func (c *Cache) Set(key string, response []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) > sweepInterval || len(c.entries) >= c.size {
+		c.sweep(now)
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		var first string
+		for key, e := range c.entries {
+			if first == "" || e.expires.Before(c.entries[first].expires) {
+				first = key
+			}
+		}
+		delete(c.entries, first)
+	}
+	c.entries[key] = &entry{response: response, expires: now.Add(ttl)}
+}
+
+// sweep removes expired responses.
+func (c *Cache) sweep(now time.Time) {
+	for key, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.lastSweep = now
+}
@@ -0,0 +1,78 @@
+package autocomplete
+
+import (
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Key returns the key the response to the interaction is cached by: the Command, the full command name, the focused option's name and value,
+// and what the Command's cache varies by. Returns false if no option is focused.
+func Key(cmd *powergridv10.Command, interaction *discordgo.Interaction) (string, bool) {
+	name, options := powergrid.FullCommand(interaction.ApplicationCommandData())
+	i := slices.IndexFunc(options, func(option *discordgo.ApplicationCommandInteractionDataOption) bool {
+		return option.Focused
+	})
+	if i == -1 {
+		return "", false
+	}
+
+	parts := []string{cmd.Namespace, cmd.Name, strings.Join(name, " "), options[i].Name, fmt.Sprint(options[i].Value)}
+	for _, vary := range cmd.Spec.AutocompleteCache.VaryBy {
+		switch vary {
+		case powergridv10.AutocompleteCacheVaryByUser:
+			parts = append(parts, "user="+routing.UserID(interaction))
+		case powergridv10.AutocompleteCacheVaryByGuild:
+			parts = append(parts, "guild="+interaction.GuildID)
+		case powergridv10.AutocompleteCacheVaryByLocale:
+			parts = append(parts, "locale="+string(interaction.Locale))
+		case powergridv10.AutocompleteCacheVaryByOptions:
+			for _, option := range options {
+				if !option.Focused {
+					parts = append(parts, option.Name+"="+fmt.Sprint(option.Value))
+				}
+			}
+		}
+	}
+	return strings.Join(parts, "\x00"), true
+}
+
+// TTL returns how long a response is cached for, using the s-maxage or max-age of its Cache-Control header instead of ttl if set.
+// Responses with no-store or no-cache are not cached, and neither are private responses unless private is set, i.e. the cache varies by user.
+func TTL(cacheControl string, ttl time.Duration, private bool) time.Duration {
+	maxAge, sharedMaxAge := -1, -1
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+		switch strings.ToLower(name) {
+		case "no-store", "no-cache":
+			return 0
+		case "private":
+			if !private {
+				return 0
+			}
+		case "max-age":
+			if err == nil {
+				maxAge = seconds
+			}
+		case "s-maxage":
+			if err == nil {
+				sharedMaxAge = seconds
+			}
+		}
+	}
+
+	switch {
+	case sharedMaxAge >= 0:
+		return time.Duration(sharedMaxAge) * time.Second
+	case maxAge >= 0:
+		return time.Duration(maxAge) * time.Second
+	}
+	return ttl
+}
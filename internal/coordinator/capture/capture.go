@@ -0,0 +1,119 @@
+package capture
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Redacted replaces the values of sensitive fields in captured interactions.
+const Redacted = "[redacted]"
+
+// maxResponseSize is the maximum length of a captured response body, longer responses are truncated.
+const maxResponseSize = 64 << 10
+
+// Capture is a forwarded interaction and the response of the service, with tokens redacted.
+type Capture struct {
+	// ID is the ID of the interaction.
+	ID      string    `json:"id"`
+	Time    time.Time `json:"time"`
+	Route   string    `json:"route"`
+	Service string    `json:"service"`
+	// Path is the path the interaction was sent to on the service.
+	Path string `json:"path"`
+
+	Interaction json.RawMessage `json:"interaction"`
+
+	Status   int    `json:"status,omitempty"`
+	Response string `json:"response,omitempty"`
+	// Error is set if the interaction could not be sent to the service, or the response could not be written to Discord.
+	Error          string `json:"error,omitempty"`
+	DurationMillis int64  `json:"durationMillis"`
+}
+
+// Sink stores captures.
+type Sink interface {
+	Record(c *Capture) error
+}
+
+var sink Sink
+
+// Init enables capturing to the given sink, either "memory" for a ring buffer of the last size captures, or "file" to append JSON lines to path.
+// Capturing is disabled if mode is empty.
+func Init(mode string, path string, size int) error {
+	var err error
+	switch mode {
+	case "":
+		return nil
+	case "memory":
+		sink = NewRing(size)
+	case "file":
+		sink, err = NewFile(path)
+	default:
+		err = fmt.Errorf("unknown capture mode %s", mode)
+	}
+	return err
+}
+
+// Enabled indicates whether forwarded interactions should be captured.
+func Enabled() bool {
+	return sink != nil
+}
+
+// Record sanitizes and stores the capture.
+func Record(c *Capture) error {
+	c.Interaction = Sanitize(c.Interaction)
+	if len(c.Response) > maxResponseSize {
+		c.Response = c.Response[:maxResponseSize]
+	}
+	return sink.Record(c)
+}
+
+// Recent returns the captures in the ring buffer, oldest first, or nil if captures are not kept in memory.
+func Recent() []*Capture {
+	ring, ok := sink.(*Ring)
+	if !ok {
+		return nil
+	}
+	return ring.List()
+}
+
+// Sanitize redacts interaction tokens and other secrets in the interaction JSON.
+// Invalid JSON is dropped entirely, as it can't be redacted.
+func Sanitize(body []byte) json.RawMessage {
+	var v interface{}
+	err := json.Unmarshal(body, &v)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	b, err := json.Marshal(redact(v))
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return b
+}
+
+// sensitiveFields are the names of fields which are redacted at any depth.
+var sensitiveFields = map[string]bool{
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if sensitiveFields[key] {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = redact(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redact(value)
+		}
+	}
+	return v
+}
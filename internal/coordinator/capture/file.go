@@ -0,0 +1,40 @@
+package capture
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// File is a Sink which appends captures to a file as JSON lines, which can be replayed with powergridctl replay.
+type File struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+var _ Sink = &File{}
+
+func NewFile(path string) (*File, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &File{file: file}, nil
+}
+
+func (f *File) Record(c *Capture) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	_, err = f.file.Write(append(b, '\n'))
+	return err
+}
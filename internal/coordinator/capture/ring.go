@@ -0,0 +1,42 @@
+package capture
+
+import "sync"
+
+// Ring is a Sink which keeps the most recent captures in memory.
+type Ring struct {
+	mutex    sync.Mutex
+	captures []*Capture
+	next     int
+	full     bool
+}
+
+var _ Sink = &Ring{}
+
+func NewRing(size int) *Ring {
+	return &Ring{
+		captures: make([]*Capture, max(size, 1)),
+	}
+}
+
+func (r *Ring) Record(c *Capture) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.captures[r.next] = c
+	r.next = (r.next + 1) % len(r.captures)
+	if r.next == 0 {
+		r.full = true
+	}
+	return nil
+}
+
+// List returns the captures, oldest first.
+func (r *Ring) List() []*Capture {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.full {
+		return append([]*Capture(nil), r.captures[:r.next]...)
+	}
+	return append(append([]*Capture(nil), r.captures[r.next:]...), r.captures[:r.next]...)
+}
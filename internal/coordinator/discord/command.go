@@ -4,12 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/appcommand"
 	"github.com/sportshead/powergrid/internal/coordinator/env"
 	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
 	"github.com/sportshead/powergrid/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"log/slog"
-	"reflect"
 	"slices"
+	"sync"
+	"time"
+)
+
+// Reasons of the events recorded on Command objects.
+const (
+	ReasonCommandParseFailed  = "CommandParseFailed"
+	ReasonCommandCreateFailed = "CommandCreateFailed"
+	ReasonCommandUpdateFailed = "CommandUpdateFailed"
 )
 
 type commandObject struct {
@@ -22,86 +33,137 @@ func removeFromList(s []interface{}, i int) []interface{} {
 	return s[:len(s)-1]
 }
 
-func UpdateCommands(ctx context.Context, list []interface{}) {
+// SyncResult is the outcome of a call to UpdateCommands, listing the names of the commands affected.
+type SyncResult struct {
+	Time      time.Time `json:"time"`
+	Created   []string  `json:"created"`
+	Updated   []string  `json:"updated"`
+	Deleted   []string  `json:"deleted"`
+	Unchanged []string  `json:"unchanged"`
+	// Failed maps the names of commands, or namespace/name of Command objects which failed to parse, to the error.
+	Failed map[string]string `json:"failed"`
+	// Error is set if the existing commands could not be listed, in which case nothing was synced.
+	Error string `json:"error,omitempty"`
+}
+
+func (r *SyncResult) fail(name string, err error) {
+	r.Failed[name] = err.Error()
+}
+
+var lastSync *SyncResult
+var lastSyncMutex sync.Mutex
+
+// LastSync returns the outcome of the last call to UpdateCommands, or nil if this replica has not led yet.
+func LastSync() *SyncResult {
+	lastSyncMutex.Lock()
+	defer lastSyncMutex.Unlock()
+	return lastSync
+}
+
+// UpdateCommands creates, edits and deletes the application commands to match the list of Command objects.
+// Failures caused by a Command object are recorded as events on it, and the guilds of its permissions are stored with writeStatus.
+func UpdateCommands(ctx context.Context, list []interface{}, recorder record.EventRecorder, writeStatus StatusWriter) {
+	result := &SyncResult{
+		Time:   time.Now(),
+		Failed: make(map[string]string),
+	}
+	defer func() {
+		lastSyncMutex.Lock()
+		lastSync = result
+		lastSyncMutex.Unlock()
+	}()
+
 	commands, err := Session.ApplicationCommands(env.DiscordApplicationID, env.DiscordGuildID, discordgo.WithContext(ctx))
 	if err != nil {
 		slog.Error("failed to get commands", utils.Tag("discord_commands_failed"), utils.Error(err))
+		result.Error = err.Error()
 		return
 	}
 
+	// Commands are parsed once, and left out if their command object is invalid. The event is recorded by validateCommand.
+	names := make(map[*powergridv10.Command]string, len(list))
+	valid := make([]interface{}, 0, len(list))
+	for _, i := range list {
+		powergridCommand := i.(*powergridv10.Command)
+		cmd := &commandObject{}
+		err = json.Unmarshal(powergridCommand.Spec.Command.Raw, cmd)
+		if err != nil {
+			slog.Error("failed to parse command object", utils.Tag("k8s_command_parse_failed"), utils.Error(err), slog.String("object", utils.TryMarshal(powergridCommand)))
+			result.fail(powergridCommand.Namespace+"/"+powergridCommand.Name, err)
+			continue
+		}
+		names[powergridCommand] = cmd.Name
+		valid = append(valid, powergridCommand)
+	}
+	list = valid
+
 	for _, oldCommand := range commands {
 		log := slog.With(slog.String("command", oldCommand.Name), slog.String("id", oldCommand.ID), slog.String("version", oldCommand.Version))
 		i := slices.IndexFunc(list, func(i interface{}) bool {
-			powergridCommand := i.(*powergridv10.Command)
-			cmd := &commandObject{}
-			err = json.Unmarshal(powergridCommand.Spec.Command.Raw, cmd)
-			if err != nil {
-				log.Error("failed to parse command object", utils.Tag("k8s_command_parse_failed"), utils.Error(err), slog.String("object", utils.TryMarshal(powergridCommand)))
-				return false
-			}
-			return oldCommand.Name == cmd.Name
+			return names[i.(*powergridv10.Command)] == oldCommand.Name
 		})
 
 		if i == -1 {
 			err = Session.ApplicationCommandDelete(oldCommand.ApplicationID, oldCommand.GuildID, oldCommand.ID, discordgo.WithContext(ctx))
 			if err != nil {
 				log.Error("failed to delete command", utils.Tag("discord_command_delete_failed"), utils.Error(err))
+				result.fail(oldCommand.Name, err)
 				continue
 			}
 			log.Info("deleted command", utils.Tag("discord_command_delete"))
+			result.Deleted = append(result.Deleted, oldCommand.Name)
 			continue
 		}
 
 		powergridCommand := list[i].(*powergridv10.Command)
-		newCommand := &discordgo.ApplicationCommand{
-			ID:            oldCommand.ID,
-			ApplicationID: oldCommand.ApplicationID,
-			GuildID:       oldCommand.GuildID,
-			Version:       oldCommand.Version,
-		}
-		err = json.Unmarshal(powergridCommand.Spec.Command.Raw, newCommand)
+		var newCommand *discordgo.ApplicationCommand
+		newCommand, err = appcommand.Parse(powergridCommand, oldCommand)
 		if err != nil {
 			log.Error("failed to parse command object", utils.Tag("k8s_command_parse_failed"), utils.Error(err), slog.String("object", utils.TryMarshal(powergridCommand)))
+			recorder.Eventf(powergridCommand, corev1.EventTypeWarning, ReasonCommandParseFailed, "Failed to parse command: %v", err)
+			result.fail(powergridCommand.Namespace+"/"+powergridCommand.Name, err)
 			continue
 		}
 
-		// set defaults
-		if newCommand.Type == 0 {
-			newCommand.Type = discordgo.ChatApplicationCommand
-		}
-		if newCommand.NSFW == nil {
-			newCommand.NSFW = utils.Ptr(false)
-		}
-
-		if oldCommand.Type != newCommand.Type || !reflect.DeepEqual(oldCommand, newCommand) {
+		if !appcommand.Equal(oldCommand, newCommand) {
 			var edited *discordgo.ApplicationCommand
 			edited, err = Session.ApplicationCommandEdit(oldCommand.ApplicationID, oldCommand.GuildID, oldCommand.ID, newCommand, discordgo.WithContext(ctx))
 			if err != nil {
 				log.Error("failed to edit command", utils.Tag("discord_command_edit_failed"), utils.Error(err))
+				recorder.Eventf(powergridCommand, corev1.EventTypeWarning, ReasonCommandUpdateFailed, "Failed to update command in Discord: %v", err)
+				result.fail(oldCommand.Name, err)
 				continue
 			}
 			log.Info("updated command", utils.Tag("discord_command_updated"), slog.String("new_version", edited.Version))
+			result.Updated = append(result.Updated, oldCommand.Name)
 		} else {
 			log.Debug("command unchanged", utils.Tag("discord_command_unchanged"))
+			result.Unchanged = append(result.Unchanged, oldCommand.Name)
 		}
+		updatePermissions(ctx, log, powergridCommand, oldCommand.ID, writeStatus)
 		list = removeFromList(list, i)
 	}
 
 	for _, i := range list {
 		powergridCommand := i.(*powergridv10.Command)
 		log := slog.With(slog.String("name", powergridCommand.Name))
-		newCommand := &discordgo.ApplicationCommand{}
-		err = json.Unmarshal(powergridCommand.Spec.Command.Raw, newCommand)
+		newCommand, err := appcommand.Parse(powergridCommand, nil)
 		if err != nil {
 			log.Error("failed to parse command object", utils.Tag("k8s_command_parse_failed"), utils.Error(err), slog.String("object", utils.TryMarshal(powergridCommand)))
+			recorder.Eventf(powergridCommand, corev1.EventTypeWarning, ReasonCommandParseFailed, "Failed to parse command: %v", err)
+			result.fail(powergridCommand.Namespace+"/"+powergridCommand.Name, err)
 			continue
 		}
 		var created *discordgo.ApplicationCommand
 		created, err = Session.ApplicationCommandCreate(env.DiscordApplicationID, env.DiscordGuildID, newCommand, discordgo.WithContext(ctx))
 		if err != nil {
 			log.Error("failed to create command", utils.Tag("discord_command_create_failed"), utils.Error(err))
+			recorder.Eventf(powergridCommand, corev1.EventTypeWarning, ReasonCommandCreateFailed, "Failed to create command in Discord: %v", err)
+			result.fail(newCommand.Name, err)
 			continue
 		}
 		log.Info("created command", utils.Tag("discord_command_created"), slog.String("command", created.Name), slog.String("id", created.ID))
+		result.Created = append(result.Created, created.Name)
+		updatePermissions(ctx, log, powergridCommand, created.ID, writeStatus)
 	}
 }
@@ -1,11 +1,15 @@
 package discord
 
 import (
+	"errors"
 	"github.com/bwmarrin/discordgo"
 	"github.com/sportshead/powergrid/internal/coordinator/env"
 	"github.com/sportshead/powergrid/pkg/utils"
 	"log/slog"
+	"net/http"
 	"os"
+	"sync/atomic"
+	"time"
 )
 
 // Session is a discordgo session for use with the Discord REST API.
@@ -26,4 +30,53 @@ func Init() {
 		slog.Error("failed to create discord session", utils.Tag("discord_session_failed"), utils.Error(err))
 		os.Exit(1)
 	}
+
+	initOAuth()
+
+	go validateSessionLoop()
+}
+
+const (
+	// validateInterval is how often the bot token is checked once it is valid.
+	validateInterval = 5 * time.Minute
+	// validateRetryInterval is how often the bot token is checked until it is valid.
+	validateRetryInterval = 10 * time.Second
+)
+
+// sessionValid is set once the bot token has been accepted by the Discord API, and cleared if it is rejected.
+var sessionValid atomic.Bool
+
+// SessionValid indicates whether the bot token was accepted by the Discord API when last checked.
+func SessionValid() bool {
+	return sessionValid.Load()
+}
+
+func validateSessionLoop() {
+	for {
+		validateSession()
+		if sessionValid.Load() {
+			time.Sleep(validateInterval)
+		} else {
+			time.Sleep(validateRetryInterval)
+		}
+	}
+}
+
+// validateSession fetches the bot user to check the token. Network errors leave the previous state unchanged.
+func validateSession() {
+	user, err := Session.User("@me")
+	if err == nil {
+		if !sessionValid.Swap(true) {
+			slog.Info("validated discord session", utils.Tag("discord_session_valid"), slog.String("user", user.ID))
+		}
+		return
+	}
+
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusUnauthorized {
+		sessionValid.Store(false)
+		slog.Error("discord rejected bot token", utils.Tag("discord_session_invalid"), utils.Error(err))
+		return
+	}
+	slog.Warn("failed to validate discord session", utils.Tag("discord_session_check_failed"), utils.Error(err))
 }
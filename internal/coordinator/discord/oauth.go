@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"context"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// OAuthTokenURL is the Discord OAuth2 token exchange endpoint.
+var OAuthTokenURL = discordgo.EndpointOAuth2 + "token"
+
+// bearerTokenSource provides the bearer token used for endpoints which do not accept bot tokens, such as command permissions.
+var bearerTokenSource oauth2.TokenSource
+
+func initOAuth() {
+	if env.DiscordOAuthBearerToken != "" {
+		bearerTokenSource = oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: env.DiscordOAuthBearerToken,
+			TokenType:   "Bearer",
+		})
+		return
+	}
+
+	config := &clientcredentials.Config{
+		ClientID:     env.DiscordApplicationID,
+		ClientSecret: env.DiscordOAuthSecret,
+		TokenURL:     OAuthTokenURL,
+		Scopes:       []string{"applications.commands.permissions.update"},
+	}
+	// token source caches the token, and refreshes it when expired
+	bearerTokenSource = config.TokenSource(context.Background())
+}
+
+// withBearerToken returns a discordgo.RequestOption which authenticates the request with the OAuth2 bearer token instead of the bot token.
+func withBearerToken() (discordgo.RequestOption, error) {
+	token, err := bearerTokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	return discordgo.WithHeader("Authorization", token.Type()+" "+token.AccessToken), nil
+}
@@ -0,0 +1,191 @@
+package discord
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// reconciledCommand is the Command generation and command ID whose permissions were last reconciled without errors.
+type reconciledCommand struct {
+	generation int64
+	commandID  string
+}
+
+// appliedPermissions caches what was last applied for a Command.
+type appliedPermissions struct {
+	// commandID is the command ID the overwrites were applied to. They are dropped once the command ID changes.
+	commandID string
+	// permissions are the overwrites last applied, or found, in each guild, so unchanged guilds aren't fetched again.
+	permissions map[string][]*discordgo.ApplicationCommandPermissions
+	// guilds were last written to the Command's status, which the informer may not have caught up with yet.
+	guilds []string
+}
+
+// StatusWriter stores the status of a Command object.
+type StatusWriter func(ctx context.Context, command *powergridv10.Command, status *powergridv10.CommandStatus) error
+
+// reconciled skips Commands which haven't changed since their permissions were reconciled, keyed by the Command's UID.
+// applied is keyed by the Command's UID. Both are dropped by ForgetPermissions once the Command is deleted.
+var reconciled = make(map[types.UID]reconciledCommand)
+var applied = make(map[types.UID]*appliedPermissions)
+var permissionsMutex sync.Mutex
+
+// ForgetPermissions drops what was cached while reconciling the permissions of a deleted Command.
+func ForgetPermissions(command *powergridv10.Command) {
+	permissionsMutex.Lock()
+	defer permissionsMutex.Unlock()
+	delete(reconciled, command.UID)
+	delete(applied, command.UID)
+}
+
+func comparePermissions(a, b *discordgo.ApplicationCommandPermissions) int {
+	if a.Type != b.Type {
+		return cmp.Compare(a.Type, b.Type)
+	}
+	return cmp.Compare(a.ID, b.ID)
+}
+
+// updatePermissions reconciles the per-guild permission overwrites of a command with Discord.
+// Permissions are only reconciled when the Command's generation or the command ID changes, as every guild needs a request.
+// The guilds in the spec are stored on the Command's status with writeStatus, so overwrites of guilds removed from the spec are reset,
+// even after a restart or a change of leader.
+func updatePermissions(ctx context.Context, log *slog.Logger, powergridCommand *powergridv10.Command, commandID string, writeStatus StatusWriter) {
+	permissionsMutex.Lock()
+	defer permissionsMutex.Unlock()
+
+	key := powergridCommand.UID
+	state := reconciledCommand{generation: powergridCommand.Generation, commandID: commandID}
+	if reconciled[key] == state {
+		return
+	}
+
+	cache := applied[key]
+	if cache == nil {
+		cache = &appliedPermissions{}
+		applied[key] = cache
+	}
+	if cache.commandID != commandID {
+		cache.commandID = commandID
+		cache.permissions = make(map[string][]*discordgo.ApplicationCommandPermissions)
+	}
+
+	ok := true
+	var specGuilds []string
+	for _, guild := range powergridCommand.Spec.Permissions {
+		log := log.With(slog.String("guild", guild.GuildID))
+		specGuilds = append(specGuilds, guild.GuildID)
+
+		wanted := make([]*discordgo.ApplicationCommandPermissions, len(guild.Permissions))
+		for i, p := range guild.Permissions {
+			wanted[i] = &discordgo.ApplicationCommandPermissions{
+				ID:         p.ID,
+				Type:       discordgo.ApplicationCommandPermissionType(p.Type),
+				Permission: p.Permission,
+			}
+		}
+		slices.SortFunc(wanted, comparePermissions)
+
+		if equalPermissions(cache.permissions[guild.GuildID], wanted) {
+			continue
+		}
+
+		var current []*discordgo.ApplicationCommandPermissions
+		existing, err := Session.ApplicationCommandPermissions(env.DiscordApplicationID, guild.GuildID, commandID, discordgo.WithContext(ctx))
+		var restErr *discordgo.RESTError
+		if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound {
+			// no overwrites have been set yet
+		} else if err != nil {
+			log.Error("failed to get command permissions", utils.Tag("discord_permissions_get_failed"), utils.Error(err))
+			ok = false
+			continue
+		} else {
+			current = existing.Permissions
+			slices.SortFunc(current, comparePermissions)
+		}
+
+		if equalPermissions(current, wanted) {
+			log.Debug("command permissions unchanged", utils.Tag("discord_permissions_unchanged"))
+			cache.permissions[guild.GuildID] = wanted
+			continue
+		}
+
+		err = editPermissions(ctx, guild.GuildID, commandID, wanted)
+		if err != nil {
+			log.Error("failed to edit command permissions", utils.Tag("discord_permissions_edit_failed"), utils.Error(err))
+			ok = false
+			continue
+		}
+		cache.permissions[guild.GuildID] = wanted
+		log.Info("updated command permissions", utils.Tag("discord_permissions_updated"), slog.Int("count", len(wanted)))
+	}
+
+	previous := slices.Clone(powergridCommand.Status.PermissionGuilds)
+	for _, guildID := range cache.guilds {
+		if !slices.Contains(previous, guildID) {
+			previous = append(previous, guildID)
+		}
+	}
+	// guilds which couldn't be reset are kept, to be retried on the next sync
+	for _, guildID := range previous {
+		if slices.Contains(specGuilds, guildID) {
+			continue
+		}
+		log := log.With(slog.String("guild", guildID))
+
+		err := editPermissions(ctx, guildID, commandID, []*discordgo.ApplicationCommandPermissions{})
+		if err != nil {
+			log.Error("failed to reset command permissions", utils.Tag("discord_permissions_reset_failed"), utils.Error(err))
+			ok = false
+			specGuilds = append(specGuilds, guildID)
+			continue
+		}
+		delete(cache.permissions, guildID)
+		log.Info("reset command permissions", utils.Tag("discord_permissions_reset"))
+	}
+
+	slices.Sort(specGuilds)
+	if !slices.Equal(specGuilds, powergridCommand.Status.PermissionGuilds) {
+		err := writeStatus(ctx, powergridCommand, &powergridv10.CommandStatus{PermissionGuilds: specGuilds})
+		if err != nil {
+			log.Error("failed to write command status", utils.Tag("k8s_command_status_failed"), utils.Error(err))
+			ok = false
+		}
+	}
+	cache.guilds = specGuilds
+
+	// failed guilds are retried on the next sync
+	if ok {
+		reconciled[key] = state
+	}
+}
+
+// editPermissions replaces the overwrites of the command in the guild, with an empty list resetting them.
+func editPermissions(ctx context.Context, guildID string, commandID string, permissions []*discordgo.ApplicationCommandPermissions) error {
+	bearer, err := withBearerToken()
+	if err != nil {
+		return err
+	}
+	return Session.ApplicationCommandPermissionsEdit(env.DiscordApplicationID, guildID, commandID, &discordgo.ApplicationCommandPermissionsList{
+		Permissions: permissions,
+	}, discordgo.WithContext(ctx), bearer)
+}
+
+// equalPermissions compares sorted lists of overwrites. A nil list is not equal to anything, as it means nothing was cached.
+func equalPermissions(a, b []*discordgo.ApplicationCommandPermissions) bool {
+	if a == nil {
+		return false
+	}
+	return slices.EqualFunc(a, b, func(a, b *discordgo.ApplicationCommandPermissions) bool {
+		return *a == *b
+	})
+}
@@ -0,0 +1,74 @@
+package discord
+
+import (
+	"cmp"
+	"context"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"reflect"
+	"slices"
+)
+
+func toLocaleMap(m map[string]string) map[discordgo.Locale]string {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[discordgo.Locale]string, len(m))
+	for k, v := range m {
+		out[discordgo.Locale(k)] = v
+	}
+	return out
+}
+
+func normalizeRoleConnectionMetadata(metadata []*discordgo.ApplicationRoleConnectionMetadata) {
+	for _, m := range metadata {
+		if len(m.NameLocalizations) == 0 {
+			m.NameLocalizations = nil
+		}
+		if len(m.DescriptionLocalizations) == 0 {
+			m.DescriptionLocalizations = nil
+		}
+	}
+	slices.SortFunc(metadata, func(a, b *discordgo.ApplicationRoleConnectionMetadata) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+}
+
+// UpdateRoleConnectionMetadata replaces the application's role connection metadata with the given list of records, if changed.
+func UpdateRoleConnectionMetadata(ctx context.Context, list []interface{}) {
+	current, err := Session.ApplicationRoleConnectionMetadata(env.DiscordApplicationID)
+	if err != nil {
+		slog.Error("failed to get role connection metadata", utils.Tag("discord_role_connection_metadata_failed"), utils.Error(err))
+		return
+	}
+
+	wanted := make([]*discordgo.ApplicationRoleConnectionMetadata, len(list))
+	for i, obj := range list {
+		record := obj.(*powergridv10.RoleConnectionMetadataRecord)
+		wanted[i] = &discordgo.ApplicationRoleConnectionMetadata{
+			Type:                     discordgo.ApplicationRoleConnectionMetadataType(record.Spec.Type),
+			Key:                      record.Spec.Key,
+			Name:                     record.Spec.Name,
+			NameLocalizations:        toLocaleMap(record.Spec.NameLocalizations),
+			Description:              record.Spec.Description,
+			DescriptionLocalizations: toLocaleMap(record.Spec.DescriptionLocalizations),
+		}
+	}
+
+	normalizeRoleConnectionMetadata(current)
+	normalizeRoleConnectionMetadata(wanted)
+	if reflect.DeepEqual(current, wanted) {
+		slog.Debug("role connection metadata unchanged", utils.Tag("discord_role_connection_metadata_unchanged"))
+		return
+	}
+
+	_, err = Session.ApplicationRoleConnectionMetadataUpdate(env.DiscordApplicationID, wanted)
+	if err != nil {
+		slog.Error("failed to update role connection metadata", utils.Tag("discord_role_connection_metadata_update_failed"), utils.Error(err), slog.String("metadata", utils.TryMarshal(wanted)))
+		return
+	}
+	slog.Info("updated role connection metadata", utils.Tag("discord_role_connection_metadata_updated"), slog.Int("count", len(wanted)))
+}
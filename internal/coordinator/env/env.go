@@ -6,6 +6,9 @@ import (
 	"github.com/sportshead/powergrid/pkg/utils"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // DISCORD_PUBLIC_KEY
@@ -20,9 +23,86 @@ var DiscordBotToken string
 // DISCORD_OAUTH_SECRET
 var DiscordOAuthSecret string
 
+// DISCORD_OAUTH_BEARER_TOKEN is an optional pre-authorized bearer token, used instead of the client credentials grant.
+var DiscordOAuthBearerToken string
+
 // DISCORD_GUID_ID
 var DiscordGuildID string
 
+// DISCORD_OAUTH_REDIRECT_URI is the public URL of the linked roles OAuth2 callback, ending in /linked-roles/callback.
+// Linked roles are disabled if unset.
+var DiscordOAuthRedirectURI string
+
+// LinkedRolesTokenStore is the store used for linked roles OAuth2 tokens, either "secret" (default) or "memory".
+// Passed in as the LINKED_ROLES_TOKEN_STORE env var.
+var LinkedRolesTokenStore string
+
+// RedisURL is the URL of the Redis server used to share rate limits between replicas, e.g. redis://localhost:6379/0
+// Rate limits are kept in memory if unset.
+// Passed in as the REDIS_URL env var.
+var RedisURL string
+
+// DeliveryQueue is the queue deferred interactions are delivered through, either "disk" or "nats".
+// Deferred interactions are delivered directly, without retries, if unset.
+// Passed in as the DELIVERY_QUEUE env var.
+var DeliveryQueue string
+
+// DeliveryQueueDir is the directory used by the disk delivery queue, which should be a persistent volume.
+// Passed in as the DELIVERY_QUEUE_DIR env var, defaults to /var/lib/powergrid/queue.
+var DeliveryQueueDir string
+
+// NATSURL is the URL of the NATS server used by the nats delivery queue, e.g. nats://localhost:4222
+// Passed in as the NATS_URL env var.
+var NATSURL string
+
+// WatchNamespaces are additional namespaces to watch for powergrid resources, besides the coordinator's namespace.
+// Passed in as the WATCH_NAMESPACES env var, separated by commas.
+var WatchNamespaces []string
+
+// WatchNamespaceSelector is a label selector for additional namespaces to watch for powergrid resources.
+// Passed in as the WATCH_NAMESPACE_SELECTOR env var.
+var WatchNamespaceSelector string
+
+// Capture records forwarded interactions and responses with tokens redacted, either to a ring buffer in "memory" or to a "file".
+// Captures can be replayed against a service with powergridctl replay. Capturing is disabled if unset.
+// Passed in as the CAPTURE env var.
+var Capture string
+
+// CaptureFile is the file captures are appended to as JSON lines.
+// Passed in as the CAPTURE_FILE env var, defaults to /var/lib/powergrid/captures.jsonl.
+var CaptureFile string
+
+// CaptureSize is the number of captures kept in memory, which are shown by the admin API.
+// Passed in as the CAPTURE_SIZE env var, defaults to 100.
+var CaptureSize int
+
+// AuditDir is the directory audit records are written to by Commands with a file audit sink.
+// Passed in as the AUDIT_DIR env var, defaults to /var/lib/powergrid/audit.
+var AuditDir string
+
+// AutocompleteCacheSize is the number of autocomplete responses kept in memory, by Commands with an autocompleteCache.
+// Passed in as the AUTOCOMPLETE_CACHE_SIZE env var, defaults to 10000.
+var AutocompleteCacheSize int
+
+// AdminToken is the bearer token required by the admin API, which listens on a separate port.
+// The admin API is disabled if unset.
+// Passed in as the ADMIN_TOKEN env var.
+var AdminToken string
+
+// RoleConnectionsToken is the bearer token required by the role connections internal API, shared with the services which use it.
+// The role connections API is disabled if unset.
+// Passed in as the ROLE_CONNECTIONS_TOKEN env var.
+var RoleConnectionsToken string
+
+// ShutdownTimeout is how long to wait for in-flight interactions to finish on shutdown.
+// Passed in as the SHUTDOWN_TIMEOUT env var, defaults to 20s.
+var ShutdownTimeout time.Duration
+
+// DrainDelay is how long to keep accepting requests on shutdown after /readyz starts failing, so the pod is removed from the service's endpoints first.
+// Should be at least the readiness probe's periodSeconds × failureThreshold.
+// Passed in as the DRAIN_DELAY env var, defaults to 10s.
+var DrainDelay time.Duration
+
 // DeploymentName is the name of the current deployment, used as the name of the leader election lease.
 // Passed in as the DEPLOYMENT_NAME env var.
 var DeploymentName string
@@ -65,9 +145,117 @@ func init() {
 		os.Exit(1)
 	}
 
+	// optional
+	DiscordOAuthBearerToken = os.Getenv("DISCORD_OAUTH_BEARER_TOKEN")
+
 	// optional
 	DiscordGuildID = os.Getenv("DISCORD_GUILD_ID")
 
+	// optional
+	DiscordOAuthRedirectURI = os.Getenv("DISCORD_OAUTH_REDIRECT_URI")
+
+	LinkedRolesTokenStore = os.Getenv("LINKED_ROLES_TOKEN_STORE")
+	if LinkedRolesTokenStore == "" {
+		LinkedRolesTokenStore = "secret"
+	}
+	if LinkedRolesTokenStore != "secret" && LinkedRolesTokenStore != "memory" {
+		slog.Error("invalid token store", utils.Tag("invalid_env"), slog.String("key", "LINKED_ROLES_TOKEN_STORE"), slog.String("value", LinkedRolesTokenStore))
+		os.Exit(1)
+	}
+
+	// optional
+	RedisURL = os.Getenv("REDIS_URL")
+
+	// optional
+	DeliveryQueue = os.Getenv("DELIVERY_QUEUE")
+	if DeliveryQueue != "" && DeliveryQueue != "disk" && DeliveryQueue != "nats" {
+		slog.Error("invalid delivery queue", utils.Tag("invalid_env"), slog.String("key", "DELIVERY_QUEUE"), slog.String("value", DeliveryQueue))
+		os.Exit(1)
+	}
+
+	DeliveryQueueDir = os.Getenv("DELIVERY_QUEUE_DIR")
+	if DeliveryQueueDir == "" {
+		DeliveryQueueDir = "/var/lib/powergrid/queue"
+	}
+
+	NATSURL = os.Getenv("NATS_URL")
+	if DeliveryQueue == "nats" && NATSURL == "" {
+		slog.Error("missing env variable", utils.Tag("invalid_env"), slog.String("key", "NATS_URL"))
+		os.Exit(1)
+	}
+
+	// optional
+	for _, ns := range strings.Split(os.Getenv("WATCH_NAMESPACES"), ",") {
+		ns = strings.TrimSpace(ns)
+		if ns != "" {
+			WatchNamespaces = append(WatchNamespaces, ns)
+		}
+	}
+
+	// optional
+	WatchNamespaceSelector = os.Getenv("WATCH_NAMESPACE_SELECTOR")
+
+	// optional
+	Capture = os.Getenv("CAPTURE")
+	if Capture != "" && Capture != "memory" && Capture != "file" {
+		slog.Error("invalid capture mode", utils.Tag("invalid_env"), slog.String("key", "CAPTURE"), slog.String("value", Capture))
+		os.Exit(1)
+	}
+
+	CaptureFile = os.Getenv("CAPTURE_FILE")
+	if CaptureFile == "" {
+		CaptureFile = "/var/lib/powergrid/captures.jsonl"
+	}
+
+	CaptureSize = 100
+	if captureSize := os.Getenv("CAPTURE_SIZE"); captureSize != "" {
+		var err error
+		CaptureSize, err = strconv.Atoi(captureSize)
+		if err != nil || CaptureSize <= 0 {
+			slog.Error("failed to parse positive integer", utils.Tag("invalid_env"), utils.Error(err), slog.String("key", "CAPTURE_SIZE"), slog.String("value", captureSize))
+			os.Exit(1)
+		}
+	}
+
+	AuditDir = os.Getenv("AUDIT_DIR")
+	if AuditDir == "" {
+		AuditDir = "/var/lib/powergrid/audit"
+	}
+
+	AutocompleteCacheSize = 10000
+	if autocompleteCacheSize := os.Getenv("AUTOCOMPLETE_CACHE_SIZE"); autocompleteCacheSize != "" {
+		var err error
+		AutocompleteCacheSize, err = strconv.Atoi(autocompleteCacheSize)
+		if err != nil || AutocompleteCacheSize <= 0 {
+			slog.Error("failed to parse positive integer", utils.Tag("invalid_env"), utils.Error(err), slog.String("key", "AUTOCOMPLETE_CACHE_SIZE"), slog.String("value", autocompleteCacheSize))
+			os.Exit(1)
+		}
+	}
+
+	// optional
+	AdminToken = os.Getenv("ADMIN_TOKEN")
+	RoleConnectionsToken = os.Getenv("ROLE_CONNECTIONS_TOKEN")
+
+	ShutdownTimeout = 20 * time.Second
+	if shutdownTimeout := os.Getenv("SHUTDOWN_TIMEOUT"); shutdownTimeout != "" {
+		var err error
+		ShutdownTimeout, err = time.ParseDuration(shutdownTimeout)
+		if err != nil {
+			slog.Error("failed to parse duration", utils.Tag("invalid_env"), utils.Error(err), slog.String("key", "SHUTDOWN_TIMEOUT"), slog.String("value", shutdownTimeout))
+			os.Exit(1)
+		}
+	}
+
+	DrainDelay = 10 * time.Second
+	if drainDelay := os.Getenv("DRAIN_DELAY"); drainDelay != "" {
+		var err error
+		DrainDelay, err = time.ParseDuration(drainDelay)
+		if err != nil {
+			slog.Error("failed to parse duration", utils.Tag("invalid_env"), utils.Error(err), slog.String("key", "DRAIN_DELAY"), slog.String("value", drainDelay))
+			os.Exit(1)
+		}
+	}
+
 	DeploymentName = os.Getenv("DEPLOYMENT_NAME")
 	if DeploymentName == "" {
 		slog.Error("missing env variable", utils.Tag("invalid_env"), slog.String("key", "DEPLOYMENT_NAME"))
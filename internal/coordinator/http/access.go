@@ -0,0 +1,29 @@
+package http
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+)
+
+// checkAccess evaluates the named access policies, otherwise responding with an access denied message.
+// Interactions are denied if a policy is missing.
+func checkAccess(log *slog.Logger, w http.ResponseWriter, interaction *discordgo.Interaction, namespace string, policies []string) bool {
+	for _, name := range policies {
+		policy, err := kubernetes.GetAccessPolicy(namespace, name)
+		if err != nil {
+			log.Error("failed to get access policy", utils.Tag("access_policy_failed"), utils.Error(err), slog.String("policy", name))
+			writeDenied(w, interaction, AccessDeniedMessage)
+			return false
+		}
+		if !routing.AllowedByPolicy(policy, interaction) {
+			log.Info("interaction denied by access policy", utils.Tag("access_denied"), slog.String("policy", name))
+			writeDenied(w, interaction, AccessDeniedMessage)
+			return false
+		}
+	}
+	return true
+}
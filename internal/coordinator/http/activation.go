@@ -0,0 +1,170 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/audit"
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// InteractionResponseDeferredMessageUpdateJSON is the JSON representation of a discordgo.InteractionResponseDeferredMessageUpdate
+	InteractionResponseDeferredMessageUpdateJSON = `{"type":6}`
+)
+
+// interactionTokenLifetime is how long follow up messages can be sent after receiving an interaction.
+const interactionTokenLifetime = 15 * time.Minute
+
+// checkActivation records activity on the service's scale target, and checks whether it is ready to receive requests.
+// Returns nil if the service can be forwarded to directly.
+func checkActivation(log *slog.Logger, namespace string, service string) *kubernetes.ScaleTarget {
+	target := kubernetes.GetScaleTarget(namespace, service)
+	if target == nil {
+		return nil
+	}
+	target.RecordActivity(log)
+	if target.Ready() {
+		return nil
+	}
+	return target
+}
+
+// activateAndForward defers the interaction, scales up the service and calls forward once it is ready.
+// forward is passed a context which expires with the interaction token.
+func activateAndForward(log *slog.Logger, w http.ResponseWriter, interaction *discordgo.Interaction, target *kubernetes.ScaleTarget, forward func(ctx context.Context)) {
+	ctx, cancel := context.WithTimeout(context.Background(), interactionTokenLifetime)
+
+	if interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		// autocomplete can't be deferred, so only start the service for the next request
+		goTracked(interaction.ID, func() {
+			defer cancel()
+			err := target.Activate(ctx, log)
+			if err != nil {
+				log.Error("failed to activate service", utils.Tag("activation_failed"), utils.Error(err))
+			}
+		})
+		writeDenied(w, interaction, "")
+		return
+	}
+
+	writeDeferred(w, interaction)
+
+	goTracked(interaction.ID, func() {
+		defer cancel()
+		err := target.Activate(ctx, log)
+		if err != nil {
+			log.Error("failed to activate service", utils.Tag("activation_failed"), utils.Error(err))
+			setAuditOutcome(interaction.ID, audit.OutcomeFailed)
+			sendFollowup(log, interaction, ForwardFailedMessage)
+			return
+		}
+		forward(ctx)
+	})
+}
+
+// writeDeferred acknowledges the interaction, to respond to it later.
+func writeDeferred(w http.ResponseWriter, interaction *discordgo.Interaction) {
+	if interaction.Type == discordgo.InteractionMessageComponent {
+		utils.WriteJSONString(w, InteractionResponseDeferredMessageUpdateJSON)
+	} else {
+		utils.WriteJSONString(w, InteractionResponseDeferredChannelMessageWithSourceJSON)
+	}
+}
+
+// forwardActivated sends the request to the service, and applies its response to the interaction acknowledged by writeDeferred.
+// Messages are sent as a follow up for components, as their deferred update leaves the component's message as @original.
+// The request is cancelled with ctx, from activateAndForward.
+func forwardActivated(ctx context.Context, log *slog.Logger, req *http.Request, interaction *discordgo.Interaction, route string, service string) {
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	res, err := serviceClient.Do(req)
+	metrics.ForwardDuration.WithLabelValues(route, service).Observe(time.Since(start).Seconds())
+	if err != nil {
+		observeForward(interaction.ID, route, service, metrics.ResultFailed)
+		log.Error("failed to forward request", utils.Tag("failed_forward_request"), utils.Error(err), slog.String("interaction", utils.TryMarshal(interaction)))
+		sendFollowup(log, interaction, ForwardFailedMessage)
+		return
+	}
+	defer res.Body.Close()
+
+	log = log.With(
+		slog.Int("status", res.StatusCode),
+		slog.String("status_text", res.Status),
+	)
+	if res.StatusCode != http.StatusOK {
+		observeForward(interaction.ID, route, service, metrics.ResultUpstreamError)
+		log.Error("upstream returned error", utils.Tag("upstream_error"), slog.String("interaction", utils.TryMarshal(interaction)))
+		sendFollowup(log, interaction, fmt.Sprintf(UpstreamErrorMessage, res.StatusCode, res.Status))
+		return
+	}
+	observeForward(interaction.ID, route, service, metrics.ResultOK)
+
+	var response struct {
+		Type discordgo.InteractionResponseType `json:"type"`
+		Data json.RawMessage                   `json:"data"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	if err != nil {
+		log.Error("failed to unmarshal json", utils.Tag("failed_unmarshal_json"), utils.Error(err))
+		sendFollowup(log, interaction, ForwardFailedMessage)
+		return
+	}
+	if len(response.Data) == 0 {
+		log.Info("handled interaction", utils.Tag("interaction_handled"))
+		return
+	}
+	if !respondDeferred(log, interaction, response.Type, response.Data) {
+		return
+	}
+
+	log.Info("handled interaction", utils.Tag("interaction_handled"))
+}
+
+// respondDeferred applies a response to the interaction acknowledged by writeDeferred, returning false if it failed.
+// Messages are sent as a follow up for components, as their deferred update leaves the component's message as @original.
+func respondDeferred(log *slog.Logger, interaction *discordgo.Interaction, responseType discordgo.InteractionResponseType, data json.RawMessage) bool {
+	switch responseType {
+	case discordgo.InteractionResponseModal:
+		log.Error("deferred interaction can't be answered with a modal", utils.Tag("failed_edit_response"))
+		sendFollowup(log, interaction, ForwardFailedMessage)
+		return false
+	case discordgo.InteractionResponseDeferredChannelMessageWithSource, discordgo.InteractionResponseDeferredMessageUpdate:
+		// the service responds to the interaction itself
+	case discordgo.InteractionResponseChannelMessageWithSource:
+		if interaction.Type == discordgo.InteractionMessageComponent {
+			err := createFollowup(interaction, data)
+			if err != nil {
+				log.Error("failed to send followup message", utils.Tag("failed_send_followup"), utils.Error(err))
+				return false
+			}
+			break
+		}
+		fallthrough
+	default:
+		err := editOriginalResponse(interaction, data)
+		if err != nil {
+			log.Error("failed to edit deferred response", utils.Tag("failed_edit_response"), utils.Error(err))
+			return false
+		}
+	}
+	return true
+}
+
+func sendFollowup(log *slog.Logger, interaction *discordgo.Interaction, message string) {
+	_, err := discord.Session.FollowupMessageCreate(interaction, false, &discordgo.WebhookParams{
+		Content: message,
+		Flags:   discordgo.MessageFlagsEphemeral,
+	})
+	if err != nil {
+		log.Error("failed to send followup message", utils.Tag("failed_send_followup"), utils.Error(err))
+	}
+}
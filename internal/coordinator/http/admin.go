@@ -0,0 +1,232 @@
+package http
+
+import (
+	"crypto/subtle"
+	"errors"
+	"github.com/sportshead/powergrid/internal/coordinator/capture"
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"github.com/sportshead/powergrid/pkg/version"
+	"io"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// adminLog discards the errors logged while resolving services for the admin API. Services which fail to resolve have no address.
+var adminLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+type adminService struct {
+	Ref     string `json:"ref"`
+	Address string `json:"address,omitempty"`
+	Scheme  string `json:"scheme,omitempty"`
+	Ready   bool   `json:"ready"`
+}
+
+type adminCommand struct {
+	Name string `json:"name"`
+	// Objects are the namespace/name of the Command objects indexed under the name.
+	Objects []string `json:"objects"`
+	// Error is the error an interaction for the command would get, such as multiple objects matching the name.
+	Error     string         `json:"error,omitempty"`
+	Transport string         `json:"transport,omitempty"`
+	External  string         `json:"external,omitempty"`
+	Services  []adminService `json:"services,omitempty"`
+	// Static is set if the coordinator answers the command with its inline response.
+	Static bool `json:"static,omitempty"`
+}
+
+type adminCommands struct {
+	Commands []adminCommand `json:"commands"`
+	// Unindexed are the namespace/name of Command objects which failed to parse.
+	Unindexed []string `json:"unindexed"`
+}
+
+type adminComponentRoute struct {
+	Prefix   string         `json:"prefix"`
+	Object   string         `json:"object"`
+	Error    string         `json:"error,omitempty"`
+	External string         `json:"external,omitempty"`
+	Services []adminService `json:"services,omitempty"`
+}
+
+type adminLeader struct {
+	Leader   string `json:"leader"`
+	Identity string `json:"identity"`
+	Leading  bool   `json:"leading"`
+}
+
+type adminState struct {
+	Commands   adminCommands            `json:"commands"`
+	Components []adminComponentRoute    `json:"components"`
+	Leader     adminLeader              `json:"leader"`
+	LastSync   *discord.SyncResult      `json:"lastSync"`
+	Backends   map[string]backendHealth `json:"backends"`
+}
+
+// initAdmin starts the admin API server if env.AdminToken is set, which shows the coordinator's view of the routing table.
+// Requests must have the token as a bearer token. Returns nil if the admin API is disabled.
+func initAdmin(stop *utils.Stopper, cleanupGroup *sync.WaitGroup) *http.Server {
+	if env.AdminToken == "" {
+		return nil
+	}
+
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc("/state", adminHandler(func() interface{} {
+		return adminState{
+			Commands:   commandsState(),
+			Components: componentsState(),
+			Leader:     leaderState(),
+			LastSync:   discord.LastSync(),
+			Backends:   backendHealthSnapshot(),
+		}
+	}))
+	serveMux.HandleFunc("/commands", adminHandler(func() interface{} { return commandsState() }))
+	serveMux.HandleFunc("/components", adminHandler(func() interface{} { return componentsState() }))
+	serveMux.HandleFunc("/leader", adminHandler(func() interface{} { return leaderState() }))
+	serveMux.HandleFunc("/sync", adminHandler(func() interface{} { return discord.LastSync() }))
+	serveMux.HandleFunc("/backends", adminHandler(func() interface{} { return backendHealthSnapshot() }))
+	serveMux.HandleFunc("/captures", adminHandler(func() interface{} { return capture.Recent() }))
+
+	server := &http.Server{
+		Addr:    "0.0.0.0:8002",
+		Handler: version.Middleware("coordinator", serveMux),
+	}
+
+	cleanupGroup.Add(1)
+	go func() {
+		defer cleanupGroup.Done()
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("admin http server died", utils.Tag("http_admin_died"), utils.Error(err))
+			stop.Stop()
+		}
+	}()
+
+	slog.Info("admin http server listening", utils.Tag("http_admin_listen"), slog.String("addr", server.Addr))
+	return server
+}
+
+// adminHandler checks the bearer token, and responds with the state returned by f as JSON.
+func adminHandler(f func() interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(env.AdminToken)) != 1 {
+			slog.Warn("unauthorized admin request", utils.Tag("admin_unauthorized"), slog.String("ip", utils.GetIP(r)), slog.String("path", r.URL.Path))
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := kubernetes.Synced(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		writeJSON(slog.With(slog.String("path", r.URL.Path)), w, f())
+	}
+}
+
+func commandsState() adminCommands {
+	indexed, unindexed := kubernetes.IndexedCommands()
+	state := adminCommands{
+		Commands:  make([]adminCommand, 0, len(indexed)),
+		Unindexed: make([]string, 0, len(unindexed)),
+	}
+
+	for name, objs := range indexed {
+		command := adminCommand{Name: name}
+		for _, obj := range objs {
+			command.Objects = append(command.Objects, obj.Namespace+"/"+obj.Name)
+		}
+		slices.Sort(command.Objects)
+
+		cmd, err := kubernetes.GetCommand(name)
+		if err != nil {
+			command.Error = err.Error()
+		} else {
+			command.Transport = cmd.Spec.Transport
+			command.Static = cmd.Spec.Response != nil
+			if cmd.Spec.External != nil {
+				command.External = cmd.Spec.External.URL
+			}
+			refs := []string{cmd.Spec.ServiceName}
+			for _, backend := range cmd.Spec.Backends {
+				refs = append(refs, backend.ServiceName)
+			}
+			for _, route := range cmd.Spec.GuildRoutes {
+				refs = append(refs, route.ServiceName)
+			}
+			command.Services = resolveServices(cmd.Namespace, refs, cmd.Spec.Port)
+		}
+		state.Commands = append(state.Commands, command)
+	}
+	slices.SortFunc(state.Commands, func(a, b adminCommand) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, obj := range unindexed {
+		state.Unindexed = append(state.Unindexed, obj.Namespace+"/"+obj.Name)
+	}
+	slices.Sort(state.Unindexed)
+	return state
+}
+
+func componentsState() []adminComponentRoute {
+	routes := kubernetes.ListComponentRoutes()
+	state := make([]adminComponentRoute, 0, len(routes))
+	for _, route := range routes {
+		component := adminComponentRoute{
+			Prefix: route.Spec.Prefix,
+			Object: route.Namespace + "/" + route.Name,
+		}
+		if _, err := kubernetes.GetComponentRoute(route.Spec.Prefix); err != nil {
+			component.Error = err.Error()
+		}
+		if route.Spec.External != nil {
+			component.External = route.Spec.External.URL
+		}
+		refs := []string{route.Spec.ServiceName}
+		for _, guildRoute := range route.Spec.GuildRoutes {
+			refs = append(refs, guildRoute.ServiceName)
+		}
+		component.Services = resolveServices(route.Namespace, refs, route.Spec.Port)
+		state = append(state, component)
+	}
+	return state
+}
+
+func leaderState() adminLeader {
+	return adminLeader{
+		Leader:   kubernetes.Leader(),
+		Identity: env.Hostname,
+		Leading:  kubernetes.IsLeader(),
+	}
+}
+
+// resolveServices resolves the addresses of the unique, non-empty service references, as an interaction would.
+func resolveServices(from string, refs []string, port *intstr.IntOrString) []adminService {
+	var services []adminService
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		if ref == "" || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		addr, scheme := kubernetes.LookupServiceAddr(adminLog, from, ref, port)
+		services = append(services, adminService{
+			Ref:     ref,
+			Address: addr,
+			Scheme:  scheme,
+			Ready:   kubernetes.ServiceReady(from, ref),
+		})
+	}
+	return services
+}
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/audit"
+	"github.com/sportshead/powergrid/internal/coordinator/queue"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"sync"
+)
+
+// pendingAudit is the audit record of an interaction which is still being handled.
+type pendingAudit struct {
+	log       *slog.Logger
+	namespace string
+	sinks     []powergridv10.AuditSink
+	record    *audit.Record
+}
+
+var pendingAudits = make(map[string]*pendingAudit)
+var pendingAuditsMutex sync.Mutex
+
+// startAudit begins the audit record of the interaction, if the command is audited.
+// The record is written once all tracked work for the interaction is done, with the last outcome set by setAuditOutcome.
+func startAudit(log *slog.Logger, cmd *powergridv10.Command, interaction *discordgo.Interaction) {
+	if cmd.Spec.Audit == nil || len(cmd.Spec.Audit.Sinks) == 0 || interaction.Type != discordgo.InteractionApplicationCommand {
+		return
+	}
+
+	pendingAuditsMutex.Lock()
+	defer pendingAuditsMutex.Unlock()
+	pendingAudits[interaction.ID] = &pendingAudit{
+		log:       log,
+		namespace: cmd.Namespace,
+		sinks:     cmd.Spec.Audit.Sinks,
+		record:    audit.NewRecord(cmd, interaction),
+	}
+}
+
+// setAuditOutcome sets the outcome of the interaction's audit record, if it has one.
+func setAuditOutcome(id string, outcome string) {
+	pendingAuditsMutex.Lock()
+	defer pendingAuditsMutex.Unlock()
+	if pending, ok := pendingAudits[id]; ok {
+		pending.record.Outcome = outcome
+	}
+}
+
+// flushAudit writes the interaction's audit record, if it has one. Called once all tracked work for the interaction is done.
+func flushAudit(id string) {
+	pendingAuditsMutex.Lock()
+	pending, ok := pendingAudits[id]
+	delete(pendingAudits, id)
+	pendingAuditsMutex.Unlock()
+	if !ok {
+		return
+	}
+
+	goTracked(id, func() {
+		audit.Write(pending.log, externalClient, pending.namespace, pending.sinks, pending.record)
+	})
+}
+
+// auditDelivery copies the pending audit record of the interaction to the delivery, to be written by deliver with its final outcome.
+func auditDelivery(d *queue.Delivery) {
+	pendingAuditsMutex.Lock()
+	defer pendingAuditsMutex.Unlock()
+	if pending, ok := pendingAudits[d.ID]; ok {
+		b, err := json.Marshal(pending.record)
+		if err != nil {
+			pending.log.Error("failed to marshal audit record", utils.Tag("audit_write_failed"), utils.Error(err))
+			return
+		}
+		d.Audit = b
+		d.AuditSinks = pending.sinks
+		d.AuditNamespace = pending.namespace
+	}
+}
+
+// forgetAudit drops the pending audit record of the interaction, once it is carried by a delivery instead.
+func forgetAudit(id string) {
+	pendingAuditsMutex.Lock()
+	defer pendingAuditsMutex.Unlock()
+	delete(pendingAudits, id)
+}
+
+// writeDeliveryAudit writes the audit record carried by the delivery with its final outcome, if it has one.
+func writeDeliveryAudit(log *slog.Logger, d *queue.Delivery, outcome string) {
+	if len(d.Audit) == 0 {
+		return
+	}
+	record := audit.Record{}
+	err := json.Unmarshal(d.Audit, &record)
+	if err != nil {
+		log.Error("failed to unmarshal audit record", utils.Tag("audit_write_failed"), utils.Error(err))
+		return
+	}
+	record.Outcome = outcome
+	goTracked(d.ID, func() {
+		audit.Write(log, externalClient, d.AuditNamespace, d.AuditSinks, &record)
+	})
+}
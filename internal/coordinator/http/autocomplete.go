@@ -0,0 +1,102 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/autocomplete"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+var autocompleteCache = autocomplete.NewCache(env.AutocompleteCacheSize)
+
+// pendingAutocomplete is where the response to an autocomplete interaction is cached, once the service responds.
+type pendingAutocomplete struct {
+	key     string
+	ttl     time.Duration
+	private bool
+}
+
+var pendingAutocompletes = make(map[string]*pendingAutocomplete)
+var pendingAutocompletesMutex sync.Mutex
+
+// writeCachedAutocomplete responds with the cached response to the autocomplete interaction, if the command has an autocompleteCache.
+// Otherwise, the service's response is cached by cacheAutocomplete once it is forwarded.
+func writeCachedAutocomplete(log *slog.Logger, w http.ResponseWriter, cmd *powergridv10.Command, interaction *discordgo.Interaction) bool {
+	if cmd.Spec.AutocompleteCache == nil || interaction.Type != discordgo.InteractionApplicationCommandAutocomplete {
+		return false
+	}
+	key, ok := autocomplete.Key(cmd, interaction)
+	if !ok {
+		return false
+	}
+
+	route := interaction.ApplicationCommandData().Name
+	if response, ok := autocompleteCache.Get(key); ok {
+		metrics.AutocompleteCacheRequests.WithLabelValues(route, metrics.CacheHit).Inc()
+		w.Header().Set("Content-Type", utils.MimeTypeJSON)
+		_, err := w.Write(response)
+		if err != nil {
+			log.Error("failed to write cached autocomplete response", utils.Tag("failed_write_body"), utils.Error(err))
+			return true
+		}
+		log.Info("responded with cached autocomplete response", utils.Tag("autocomplete_cache_hit"))
+		return true
+	}
+	metrics.AutocompleteCacheRequests.WithLabelValues(route, metrics.CacheMiss).Inc()
+
+	pendingAutocompletesMutex.Lock()
+	defer pendingAutocompletesMutex.Unlock()
+	pendingAutocompletes[interaction.ID] = &pendingAutocomplete{
+		key:     key,
+		ttl:     cmd.Spec.AutocompleteCache.TTL.Duration,
+		private: slices.Contains(cmd.Spec.AutocompleteCache.VaryBy, powergridv10.AutocompleteCacheVaryByUser),
+	}
+	return false
+}
+
+// cachingAutocomplete returns whether the response to the interaction should be passed to cacheAutocomplete.
+func cachingAutocomplete(id string) bool {
+	pendingAutocompletesMutex.Lock()
+	defer pendingAutocompletesMutex.Unlock()
+	_, ok := pendingAutocompletes[id]
+	return ok
+}
+
+// cacheAutocomplete caches the service's response to the interaction, if it is an autocomplete result and it is being cached.
+// cacheControl is the Cache-Control header of the response, which can override the TTL.
+func cacheAutocomplete(log *slog.Logger, id string, cacheControl string, response []byte) {
+	pendingAutocompletesMutex.Lock()
+	pending, ok := pendingAutocompletes[id]
+	delete(pendingAutocompletes, id)
+	pendingAutocompletesMutex.Unlock()
+	if !ok {
+		return
+	}
+
+	// error messages are not cached
+	var res struct {
+		Type discordgo.InteractionResponseType `json:"type"`
+	}
+	if json.Unmarshal(response, &res) != nil || res.Type != discordgo.InteractionApplicationCommandAutocompleteResult {
+		return
+	}
+
+	ttl := autocomplete.TTL(cacheControl, pending.ttl, pending.private)
+	autocompleteCache.Set(pending.key, response, ttl)
+	log.Debug("cached autocomplete response", slog.Duration("ttl", ttl))
+}
+
+// forgetAutocomplete removes the interaction's pending autocomplete response, e.g. if the service failed. Called once all tracked work for the interaction is done.
+func forgetAutocomplete(id string) {
+	pendingAutocompletesMutex.Lock()
+	defer pendingAutocompletesMutex.Unlock()
+	delete(pendingAutocompletes, id)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"github.com/sportshead/powergrid/internal/coordinator/audit"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	"sync"
+	"time"
+)
+
+// backendHealth is the outcome of the interactions recently forwarded to a service, shown by the admin API.
+type backendHealth struct {
+	LastResult          string    `json:"lastResult"`
+	LastResultAt        time.Time `json:"lastResultAt"`
+	LastSuccessAt       time.Time `json:"lastSuccessAt"`
+	LastFailureAt       time.Time `json:"lastFailureAt"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	Forwarded           int       `json:"forwarded"`
+	Failed              int       `json:"failed"`
+}
+
+var backends = make(map[string]*backendHealth)
+var backendsMutex sync.Mutex
+
+// observeForward counts an interaction forwarded to a service, by route and result, for the metrics and admin API,
+// and sets the result as the outcome of its audit record.
+// result is one of metrics.ResultOK, metrics.ResultUpstreamError or metrics.ResultFailed.
+func observeForward(id string, route string, service string, result string) {
+	metrics.ForwardedInteractions.WithLabelValues(route, service, result).Inc()
+	setAuditOutcome(id, audit.ForwardOutcome(result))
+
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+	health, ok := backends[service]
+	if !ok {
+		health = &backendHealth{}
+		backends[service] = health
+	}
+
+	now := time.Now()
+	health.LastResult = result
+	health.LastResultAt = now
+	health.Forwarded++
+	if result == metrics.ResultOK {
+		health.LastSuccessAt = now
+		health.ConsecutiveFailures = 0
+	} else {
+		health.LastFailureAt = now
+		health.ConsecutiveFailures++
+		health.Failed++
+	}
+}
+
+// backendHealthSnapshot copies the health of each service interactions have been forwarded to.
+func backendHealthSnapshot() map[string]backendHealth {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+	snapshot := make(map[string]backendHealth, len(backends))
+	for service, health := range backends {
+		snapshot[service] = *health
+	}
+	return snapshot
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/capture"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// captureForward records the interaction sent to the service and its response, if capturing is enabled.
+// The interaction is read from req.GetBody, as the body has already been sent.
+func captureForward(log *slog.Logger, req *http.Request, interaction *discordgo.Interaction, route string, service string, start time.Time, status int, response []byte, forwardErr error) {
+	if !capture.Enabled() {
+		return
+	}
+
+	var body []byte
+	if req.GetBody != nil {
+		if r, err := req.GetBody(); err == nil {
+			body, _ = io.ReadAll(r)
+		}
+	}
+
+	c := &capture.Capture{
+		ID:             interaction.ID,
+		Time:           start,
+		Route:          route,
+		Service:        service,
+		Path:           req.URL.Path,
+		Interaction:    body,
+		Status:         status,
+		Response:       string(response),
+		DurationMillis: time.Since(start).Milliseconds(),
+	}
+	if forwardErr != nil {
+		c.Error = forwardErr.Error()
+	}
+
+	err := capture.Record(c)
+	if err != nil {
+		log.Error("failed to record capture", utils.Tag("capture_record_failed"), utils.Error(err))
+	}
+}
@@ -0,0 +1,187 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/audit"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	"github.com/sportshead/powergrid/internal/coordinator/queue"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// enqueueDelivery durably queues a deferred interaction, to be delivered to the service with retries.
+// external is the Command if the request is sent to its external backend, whose headers are left out of the delivery.
+// Returns false if the interaction could not be queued.
+func enqueueDelivery(log *slog.Logger, req *http.Request, body []byte, interaction *discordgo.Interaction, route string, service string, external *powergridv10.Command) bool {
+	d := &queue.Delivery{
+		ID:            interaction.ID,
+		ApplicationID: interaction.AppID,
+		Token:         interaction.Token,
+		URL:           req.URL.String(),
+		Host:          req.Host,
+		Header:        req.Header,
+		Body:          body,
+		Route:         route,
+		Service:       service,
+		ReceivedAt:    time.Now(),
+	}
+	if external != nil {
+		d.Header = req.Header.Clone()
+		for _, header := range external.Spec.External.Headers {
+			d.Header.Del(header.Name)
+		}
+		d.External = external.Namespace + "/" + external.Name
+	}
+	auditDelivery(d)
+
+	err := queue.Enqueue(req.Context(), d)
+	if err != nil {
+		log.Error("failed to enqueue delivery", utils.Tag("queue_enqueue_failed"), utils.Error(err))
+		return false
+	}
+	// the audit record is written by deliver once the delivery succeeds or fails for good
+	forgetAudit(interaction.ID)
+	log.Info("enqueued delivery", utils.Tag("queue_enqueued"))
+	return true
+}
+
+var errRetryable = errors.New("upstream returned retryable error")
+
+var errExternalRemoved = errors.New("command no longer has an external backend")
+
+// setExternalHeaders adds the headers of the external backend of the Command with the namespace/name key to the request.
+func setExternalHeaders(ctx context.Context, req *http.Request, key string) error {
+	cmd, err := kubernetes.GetCommandByKey(key)
+	if err != nil {
+		return err
+	}
+	if cmd.Spec.External == nil {
+		return errExternalRemoved
+	}
+	for _, header := range cmd.Spec.External.Headers {
+		value, err := kubernetes.GetHeaderValue(ctx, cmd.Namespace, header)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(header.Name, value)
+	}
+	return nil
+}
+
+// deliver sends a queued interaction to its service. Like forwardInteraction with shouldDefer set, the response is ignored.
+// Network errors, 429 and 5xx responses are retried, and a follow up message is only sent once retries are exhausted.
+// The audit record of the delivery is written once it succeeds or fails for good.
+func deliver(ctx context.Context, d *queue.Delivery, last bool) error {
+	log := slog.With(
+		slog.String("id", d.ID),
+		slog.String("route", d.Route),
+		slog.String("service", d.Service),
+		slog.Int("attempts", d.Attempts),
+	)
+	interaction := &discordgo.Interaction{
+		ID:    d.ID,
+		AppID: d.ApplicationID,
+		Token: d.Token,
+	}
+
+	// deliveries interrupted by shutdown are retried, so they aren't audited yet
+	parent := ctx
+	final := func() bool {
+		return last && parent.Err() == nil
+	}
+
+	// follow up messages can't be sent once the interaction token expires
+	ctx, cancel := context.WithDeadline(ctx, d.ReceivedAt.Add(interactionTokenLifetime))
+	defer cancel()
+
+	req, err := d.NewRequest(ctx)
+	if err != nil {
+		log.Error("failed to create request", utils.Tag("failed_forward_request"), utils.Error(err))
+		writeDeliveryAudit(log, d, audit.OutcomeFailed)
+		return nil
+	}
+	if d.External != "" {
+		req = withExternal(req)
+		err = setExternalHeaders(ctx, req, d.External)
+		if err != nil {
+			log.Error("failed to get external backend headers", utils.Tag("failed_external_request"), utils.Error(err), slog.Bool("last", last))
+			if last {
+				sendFollowup(log, interaction, MissingServiceMessage)
+			}
+			if final() {
+				writeDeliveryAudit(log, d, audit.OutcomeFailed)
+			}
+			return err
+		}
+	}
+
+	start := time.Now()
+	res, err := serviceClient.Do(req)
+	metrics.ForwardDuration.WithLabelValues(d.Route, d.Service).Observe(time.Since(start).Seconds())
+	if err != nil {
+		observeForward(d.ID, d.Route, d.Service, metrics.ResultFailed)
+		log.Error("failed to forward request", utils.Tag("failed_forward_request"), utils.Error(err), slog.Bool("last", last))
+		if last {
+			sendFollowup(log, interaction, ForwardFailedMessage)
+		}
+		if final() {
+			writeDeliveryAudit(log, d, audit.OutcomeFailed)
+		}
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	log = log.With(slog.Int("status", res.StatusCode), slog.String("status_text", res.Status))
+	if res.StatusCode != http.StatusOK {
+		observeForward(d.ID, d.Route, d.Service, metrics.ResultUpstreamError)
+		retryable := res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+		log.Error("upstream returned error", utils.Tag("upstream_error"), slog.Bool("last", last), slog.Bool("retryable", retryable))
+		if !retryable || last {
+			sendFollowup(log, interaction, fmt.Sprintf(UpstreamErrorMessage, res.StatusCode, res.Status))
+		}
+		if !retryable || final() {
+			writeDeliveryAudit(log, d, audit.OutcomeUpstreamError)
+		}
+		if retryable {
+			return errRetryable
+		}
+		return nil
+	}
+	observeForward(d.ID, d.Route, d.Service, metrics.ResultOK)
+	writeDeliveryAudit(log, d, audit.OutcomeOK)
+
+	log.Info("handled interaction", utils.Tag("interaction_handled"))
+	return nil
+}
+
+// startDeliveries consumes the delivery queue until stop is stopped.
+func startDeliveries(stop *utils.Stopper, cleanupGroup *sync.WaitGroup) {
+	if !queue.Enabled() {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stop.Done()
+		cancel()
+	}()
+
+	cleanupGroup.Add(1)
+	go func() {
+		defer cleanupGroup.Done()
+		err := queue.Consume(ctx, deliver)
+		if err != nil {
+			slog.Error("failed to consume delivery queue", utils.Tag("queue_consume_failed"), utils.Error(err))
+		}
+	}()
+}
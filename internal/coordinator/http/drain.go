@@ -0,0 +1,95 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// draining is set once the coordinator starts shutting down, and makes /readyz fail.
+var draining atomic.Bool
+
+// inFlight counts the work for each interaction or event ID, which is waited for on shutdown.
+var inFlight = make(map[string]int)
+var inFlightMutex sync.Mutex
+var inFlightGroup sync.WaitGroup
+
+// track marks work for the interaction or event ID as in flight, until the returned function is called.
+// The work is waited for on shutdown, and the ID is logged if it is abandoned. Once no work is left, the audit record of the interaction is written.
+func track(id string) func() {
+	inFlightMutex.Lock()
+	inFlight[id]++
+	inFlightMutex.Unlock()
+	inFlightGroup.Add(1)
+
+	return func() {
+		inFlightMutex.Lock()
+		inFlight[id]--
+		finished := inFlight[id] <= 0
+		if finished {
+			delete(inFlight, id)
+		}
+		inFlightMutex.Unlock()
+		if finished {
+			flushAudit(id)
+			forgetAutocomplete(id)
+		}
+		inFlightGroup.Done()
+	}
+}
+
+// goTracked runs f in a tracked goroutine, such as a deferred forward.
+func goTracked(id string, f func()) {
+	done := track(id)
+	go func() {
+		defer done()
+		f()
+	}()
+}
+
+// drain stops the servers from accepting requests once env.DrainDelay passes, and waits for in-flight interactions until env.ShutdownTimeout.
+func drain(servers ...*http.Server) {
+	draining.Store(true)
+	// requests keep being routed to the pod until the failing readiness probe is noticed
+	slog.Info("waiting for readiness to propagate", utils.Tag("drain_delay"), slog.Duration("delay", env.DrainDelay))
+	time.Sleep(env.DrainDelay)
+
+	slog.Info("draining in-flight interactions", utils.Tag("drain_start"), slog.Duration("timeout", env.ShutdownTimeout))
+
+	ctx, cancel := context.WithTimeout(context.Background(), env.ShutdownTimeout)
+	defer cancel()
+
+	for _, server := range servers {
+		err := server.Shutdown(ctx)
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			slog.Error("failed to shut down http server", utils.Tag("drain_shutdown_failed"), utils.Error(err), slog.String("addr", server.Addr))
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		inFlightGroup.Wait()
+	}()
+
+	select {
+	case <-done:
+		slog.Info("drained in-flight interactions", utils.Tag("drain_finished"))
+	case <-ctx.Done():
+		inFlightMutex.Lock()
+		abandoned := make([]string, 0, len(inFlight))
+		for id := range inFlight {
+			abandoned = append(abandoned, id)
+		}
+		inFlightMutex.Unlock()
+		slices.Sort(abandoned)
+		slog.Error("abandoning in-flight interactions", utils.Tag("drain_timeout"), slog.Any("ids", abandoned))
+	}
+}
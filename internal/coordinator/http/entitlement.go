@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+)
+
+// ButtonStylePremium is a button which opens the SKU's store page.
+// See https://discord.com/developers/docs/interactions/message-components#button-object-button-styles
+const ButtonStylePremium discordgo.ButtonStyle = 6
+
+// maxPremiumButtons is the maximum number of buttons in an action row.
+const maxPremiumButtons = 5
+
+// premiumButton is a premium button, which discordgo does not support.
+type premiumButton struct {
+	SKUID string
+}
+
+func (premiumButton) Type() discordgo.ComponentType {
+	return discordgo.ButtonComponent
+}
+
+func (b premiumButton) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type  discordgo.ComponentType `json:"type"`
+		Style discordgo.ButtonStyle   `json:"style"`
+		SKUID string                  `json:"sku_id"`
+	}{
+		Type:  b.Type(),
+		Style: ButtonStylePremium,
+		SKUID: b.SKUID,
+	})
+}
+
+// writePremiumRequired responds with an ephemeral message containing premium buttons for the SKUs.
+func writePremiumRequired(w http.ResponseWriter, interaction *discordgo.Interaction, skus []string) {
+	if interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		writeDenied(w, interaction, PremiumRequiredMessage)
+		return
+	}
+
+	buttons := make([]discordgo.MessageComponent, 0, min(len(skus), maxPremiumButtons))
+	for _, sku := range skus[:cap(buttons)] {
+		buttons = append(buttons, premiumButton{SKUID: sku})
+	}
+
+	res := messageResponse(PremiumRequiredMessage)
+	res.Data.Components = []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: buttons},
+	}
+	writeResponse(w, res)
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// EventsPath is the path Discord webhook events are received on, and the path they are forwarded to on services.
+const EventsPath = "/events"
+
+const (
+	// WebhookTypePing is sent by Discord to check the endpoint is valid.
+	WebhookTypePing = 0
+	// WebhookTypeEvent contains an event in the event field.
+	WebhookTypeEvent = 1
+)
+
+// eventTimeout is how long a service can take to handle a webhook event, so hanging services don't hold up shutdown.
+const eventTimeout = 30 * time.Second
+
+// webhookEvent is the payload sent to the webhook events URL.
+// See https://discord.com/developers/docs/events/webhook-events#webhook-event-payloads
+type webhookEvent struct {
+	Version       int    `json:"version"`
+	ApplicationID string `json:"application_id"`
+	Type          int    `json:"type"`
+	Event         *struct {
+		Type      string `json:"type"`
+		Timestamp string `json:"timestamp"`
+	} `json:"event"`
+}
+
+func HandleEvents(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			slog.Error("http handler panicked", utils.Tag("http_panic"), slog.Any("error", err))
+		}
+	}()
+
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !discordgo.VerifyInteraction(r, env.DiscordPublicKey) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("failed to read request body", utils.Tag("failed_read_body"), utils.Error(err))
+		http.Error(w, "failed to read request body", http.StatusInternalServerError)
+		return
+	}
+
+	payload := &webhookEvent{}
+	err = json.Unmarshal(body, payload)
+	if err != nil {
+		slog.Error("failed to unmarshal json", utils.Tag("failed_unmarshal_json"), utils.Error(err), slog.String("body", string(body)))
+		http.Error(w, "failed to unmarshal json", http.StatusBadRequest)
+		return
+	}
+
+	// Discord expects an empty 204 response to pings and events, within 3 seconds
+	w.WriteHeader(http.StatusNoContent)
+
+	if payload.Type == WebhookTypePing {
+		slog.Info("responding to webhook ping", utils.Tag("event_pong"), slog.String("ip", utils.GetIP(r)))
+		return
+	}
+	if payload.Type != WebhookTypeEvent || payload.Event == nil {
+		slog.Warn("unknown webhook type", utils.Tag("event_unknown_type"), slog.Int("type", payload.Type), slog.String("body", string(body)))
+		return
+	}
+
+	log := slog.With(slog.String("event", payload.Event.Type), slog.String("timestamp", payload.Event.Timestamp))
+	log.Info("webhook event received", utils.Tag("event_received"))
+
+	subscriptions, err := kubernetes.GetEventSubscriptions(payload.Event.Type)
+	if err != nil {
+		log.Error("failed to get event subscriptions", utils.Tag("event_subscriptions_failed"), utils.Error(err))
+		return
+	}
+	if len(subscriptions) == 0 {
+		log.Debug("no subscriptions for event", utils.Tag("event_unsubscribed"))
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		log := log.With(slog.String("subscription", subscription.Name), slog.String("service", subscription.Spec.ServiceName))
+
+		addr, scheme := kubernetes.GetServiceAddr(log, subscription, subscription.Namespace, subscription.Spec.ServiceName, nil)
+		if addr == "" {
+			log.Error("failed to get service address", utils.Tag("failed_get_service_address"))
+			continue
+		}
+
+		if scheme == kubernetes.SchemeGRPC {
+			log.Error("events can't be sent over grpc", utils.Tag("event_grpc_unsupported"))
+			continue
+		}
+
+		req := makeRequest(r, addr, body)
+		req.URL.Scheme = scheme
+		req.URL.Path = EventsPath
+		goTracked(payload.Event.Type+"/"+subscription.Name, func() {
+			forwardEvent(log.With(slog.String("addr", addr)), req)
+		})
+	}
+}
+
+// forwardEvent delivers a webhook event to a service. The response body is ignored.
+func forwardEvent(log *slog.Logger, req *http.Request) {
+	ctx, cancel := context.WithTimeout(req.Context(), eventTimeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	res, err := serviceClient.Do(req)
+	if err != nil {
+		log.Error("failed to forward event", utils.Tag("failed_forward_event"), utils.Error(err))
+		return
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	log = log.With(slog.Int("status", res.StatusCode))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		log.Error("upstream returned error", utils.Tag("event_upstream_error"))
+		return
+	}
+	log.Info("handled event", utils.Tag("event_handled"))
+}
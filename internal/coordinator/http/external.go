@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var ErrExternalNotHTTPS = errors.New("external backend url is not https")
+
+// ErrExternalClusterLocal is returned for external backends inside the cluster, which must be referenced as a service instead,
+// so that ReferenceGrants apply to them.
+var ErrExternalClusterLocal = errors.New("external backend url is cluster-local")
+
+// sharedAddressSpace is 100.64.0.0/10, which is commonly used for pod and service networks.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// externalKey marks the context of requests to external backends.
+type externalKey struct{}
+
+// externalTransport sends requests to external backends, refusing to connect to non-public addresses after resolution.
+var externalTransport = func() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || !isPublicAddr(ip) {
+				return ErrExternalClusterLocal
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	return transport
+}()
+
+// externalClient sends requests outside of the cluster which aren't interactions, such as audit records.
+var externalClient = &http.Client{Transport: externalTransport}
+
+// makeExternalRequest builds a request to an external backend, with the same body and headers as makeRequest, plus the backend's headers.
+// namespace is the namespace Secrets referenced by the headers are read from.
+func makeExternalRequest(ctx context.Context, r *http.Request, namespace string, backend *powergridv10.ExternalBackend, body []byte) (*http.Request, error) {
+	u, err := url.Parse(backend.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, ErrExternalNotHTTPS
+	}
+	if isClusterLocalHost(u.Hostname()) {
+		return nil, ErrExternalClusterLocal
+	}
+
+	req := withExternal(makeRequest(r, u.Host, body))
+	req.URL = u
+	// the Host header is copied from the request to the coordinator otherwise
+	req.Host = u.Host
+
+	for _, header := range backend.Headers {
+		value, err := kubernetes.GetHeaderValue(ctx, namespace, header)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(header.Name, value)
+	}
+	return req, nil
+}
+
+// withExternal marks the request as being sent to an external backend, so it is sent by externalTransport.
+func withExternal(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), externalKey{}, true))
+}
+
+func isExternal(req *http.Request) bool {
+	external, _ := req.Context().Value(externalKey{}).(bool)
+	return external
+}
+
+// isClusterLocalHost checks whether the host is a service DNS name or a non-public IP literal.
+func isClusterLocalHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") ||
+		strings.HasSuffix(host, ".svc") || strings.HasSuffix(host, ".cluster.local") {
+		return true
+	}
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return !isPublicAddr(ip)
+	}
+	return false
+}
+
+// isPublicAddr checks whether the address is outside of the private, loopback, link-local and shared ranges used inside clusters.
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
@@ -0,0 +1,349 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	interactionv1 "github.com/sportshead/powergrid/pkg/proto/interaction/v1"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// grpcConns are connections to services by address. They are closed by closeGRPCConns once the address is no longer used by a Service.
+var grpcConns = make(map[string]*grpc.ClientConn)
+var grpcConnsMutex sync.Mutex
+
+// grpcClient returns a client for the service at addr, reusing connections between interactions.
+func grpcClient(addr string) (interactionv1.InteractionServiceClient, error) {
+	grpcConnsMutex.Lock()
+	defer grpcConnsMutex.Unlock()
+
+	conn, ok := grpcConns[addr]
+	if !ok {
+		var err error
+		conn, err = grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		grpcConns[addr] = conn
+	}
+	return interactionv1.NewInteractionServiceClient(conn), nil
+}
+
+// closeGRPCConns closes the connections to the addresses, which services no longer use.
+func closeGRPCConns(addrs []string) {
+	grpcConnsMutex.Lock()
+	defer grpcConnsMutex.Unlock()
+	for _, addr := range addrs {
+		conn, ok := grpcConns[addr]
+		if !ok {
+			continue
+		}
+		delete(grpcConns, addr)
+		err := conn.Close()
+		if err != nil {
+			slog.Warn("failed to close grpc connection", utils.Tag("grpc_close_failed"), utils.Error(err), slog.String("addr", addr))
+			continue
+		}
+		slog.Debug("closed grpc connection", utils.Tag("grpc_closed"), slog.String("addr", addr))
+	}
+}
+
+// forwardGRPC sends the interaction to the service over gRPC, and writes its response to Discord.
+// If deferred, the first response is applied with respondDeferred instead, unless it is empty.
+// With stream set, every response after the first is sent as a follow up message.
+func forwardGRPC(log *slog.Logger, w http.ResponseWriter, addr string, deferred bool, stream bool, interaction *discordgo.Interaction, body []byte, route string, service string) {
+	ctx, cancel := context.WithTimeout(context.Background(), interactionTokenLifetime)
+	// Discord stops waiting for the first response if not deferred, but follow ups can be streamed until the token expires
+	var firstResponse *time.Timer
+	if !deferred {
+		firstResponse = time.AfterFunc(responseTimeout, cancel)
+	}
+	// the stream is cancelled by receiveFollowups instead, if it is received in the background
+	background := false
+	defer func() {
+		if !background {
+			cancel()
+		}
+	}()
+
+	fail := func(err error) {
+		result := metrics.ResultFailed
+		message := ForwardFailedMessage
+		if s, ok := status.FromError(err); ok && s.Code() != codes.Unavailable && s.Code() != codes.DeadlineExceeded && s.Code() != codes.Canceled {
+			result = metrics.ResultUpstreamError
+			message = fmt.Sprintf(UpstreamErrorMessage, s.Code(), s.Message())
+		}
+		observeForward(interaction.ID, route, service, result)
+		log.Error("failed to forward request", utils.Tag("failed_forward_request"), utils.Error(err), slog.String("interaction", utils.TryMarshal(interaction)))
+
+		if deferred {
+			sendFollowup(log, interaction, message)
+		} else {
+			writeMessage(w, message)
+		}
+	}
+
+	client, err := grpcClient(addr)
+	if err != nil {
+		fail(err)
+		return
+	}
+	in := toProtoInteraction(interaction, body)
+
+	start := time.Now()
+	var res *interactionv1.InteractionResponse
+	var responses interactionv1.InteractionService_HandleStreamClient
+	if stream {
+		responses, err = client.HandleStream(ctx, in)
+		if err == nil {
+			res, err = responses.Recv()
+		}
+	} else {
+		res, err = client.Handle(ctx, in)
+	}
+	if firstResponse != nil {
+		firstResponse.Stop()
+	}
+	metrics.ForwardDuration.WithLabelValues(route, service).Observe(time.Since(start).Seconds())
+	if err != nil {
+		fail(err)
+		return
+	}
+	observeForward(interaction.ID, route, service, metrics.ResultOK)
+
+	if deferred {
+		if !isEmptyResponse(res) {
+			respondDeferred(log, interaction, responseType(res, interaction), responseData(res))
+		}
+	} else {
+		response := map[string]interface{}{
+			"type": responseType(res, interaction),
+			"data": responseData(res),
+		}
+		writeJSON(log, w, response)
+		if cachingAutocomplete(interaction.ID) {
+			b, _ := json.Marshal(response)
+			cacheAutocomplete(log, interaction.ID, "", b)
+		}
+	}
+
+	switch {
+	case stream && !deferred:
+		// the first response is only sent to Discord once the handler returns
+		background = true
+		goTracked(interaction.ID, func() {
+			defer cancel()
+			receiveFollowups(log, responses, interaction)
+		})
+	case stream:
+		receiveFollowups(log, responses, interaction)
+	default:
+		log.Info("handled interaction", utils.Tag("interaction_handled"))
+	}
+}
+
+// receiveFollowups sends every remaining response of the stream as a follow up message.
+func receiveFollowups(log *slog.Logger, responses interactionv1.InteractionService_HandleStreamClient, interaction *discordgo.Interaction) {
+	for {
+		res, err := responses.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Error("failed to receive follow up message", utils.Tag("grpc_stream_failed"), utils.Error(err))
+			return
+		}
+
+		err = createFollowup(interaction, responseData(res))
+		if err != nil {
+			log.Error("failed to send followup message", utils.Tag("failed_send_followup"), utils.Error(err))
+		}
+	}
+
+	log.Info("handled interaction", utils.Tag("interaction_handled"))
+}
+
+// responseType returns the type of the response, defaulting to a message or autocomplete result.
+func responseType(res *interactionv1.InteractionResponse, interaction *discordgo.Interaction) discordgo.InteractionResponseType {
+	if res.Type != interactionv1.InteractionResponseType_INTERACTION_RESPONSE_TYPE_UNSPECIFIED {
+		return discordgo.InteractionResponseType(res.Type)
+	}
+	if interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		return discordgo.InteractionApplicationCommandAutocompleteResult
+	}
+	return discordgo.InteractionResponseChannelMessageWithSource
+}
+
+func isEmptyResponse(res *interactionv1.InteractionResponse) bool {
+	return res.Content == "" && len(res.Choices) == 0 && len(res.DataJson) == 0
+}
+
+// responseData converts a response to the JSON of its interaction callback data.
+func responseData(res *interactionv1.InteractionResponse) json.RawMessage {
+	if len(res.DataJson) > 0 {
+		return res.DataJson
+	}
+
+	data := &discordgo.InteractionResponseData{
+		Content: res.Content,
+	}
+	if res.Ephemeral {
+		data.Flags = discordgo.MessageFlagsEphemeral
+	}
+	for _, choice := range res.Choices {
+		c := &discordgo.ApplicationCommandOptionChoice{Name: choice.Name}
+		switch value := choice.Value.(type) {
+		case *interactionv1.AutocompleteChoice_StringValue:
+			c.Value = value.StringValue
+		case *interactionv1.AutocompleteChoice_IntValue:
+			c.Value = value.IntValue
+		case *interactionv1.AutocompleteChoice_NumberValue:
+			c.Value = value.NumberValue
+		}
+		data.Choices = append(data.Choices, c)
+	}
+
+	b, _ := json.Marshal(data)
+	return b
+}
+
+func writeJSON(log *slog.Logger, w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", utils.MimeTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Error("failed to write response", utils.Tag("failed_write_body"), utils.Error(err))
+	}
+}
+
+// toProtoInteraction converts an interaction to its gRPC representation.
+func toProtoInteraction(interaction *discordgo.Interaction, body []byte) *interactionv1.Interaction {
+	// fields which aren't kept by discordgo
+	type rawUser struct {
+		GlobalName string `json:"global_name"`
+	}
+	var raw struct {
+		Data struct {
+			Type int32 `json:"type"`
+		} `json:"data"`
+		Member *struct {
+			User rawUser `json:"user"`
+		} `json:"member"`
+		User *rawUser `json:"user"`
+	}
+	_ = json.Unmarshal(body, &raw)
+
+	in := &interactionv1.Interaction{
+		Id:            interaction.ID,
+		ApplicationId: interaction.AppID,
+		Type:          interactionv1.InteractionType(interaction.Type),
+		Token:         interaction.Token,
+		GuildId:       interaction.GuildID,
+		ChannelId:     interaction.ChannelID,
+		Locale:        string(interaction.Locale),
+		RawJson:       body,
+	}
+	if interaction.GuildLocale != nil {
+		in.GuildLocale = string(*interaction.GuildLocale)
+	}
+
+	user := interaction.User
+	var globalName string
+	if raw.User != nil {
+		globalName = raw.User.GlobalName
+	}
+	if interaction.Member != nil {
+		user = interaction.Member.User
+		in.MemberRoleIds = interaction.Member.Roles
+		if raw.Member != nil {
+			globalName = raw.Member.User.GlobalName
+		}
+	}
+	if user != nil {
+		in.User = &interactionv1.User{
+			Id:         user.ID,
+			Username:   user.Username,
+			GlobalName: globalName,
+		}
+	}
+
+	switch data := interaction.Data.(type) {
+	case discordgo.ApplicationCommandInteractionData:
+		in.Data = &interactionv1.Interaction_Command{
+			Command: &interactionv1.ApplicationCommandData{
+				Id:       data.ID,
+				Name:     data.Name,
+				Type:     raw.Data.Type,
+				Options:  toProtoOptions(data.Options),
+				TargetId: data.TargetID,
+			},
+		}
+	case discordgo.MessageComponentInteractionData:
+		in.Data = &interactionv1.Interaction_Component{
+			Component: &interactionv1.MessageComponentData{
+				CustomId:      data.CustomID,
+				ComponentType: int32(data.ComponentType),
+				Values:        data.Values,
+			},
+		}
+	case discordgo.ModalSubmitInteractionData:
+		values := make(map[string]string)
+		for _, component := range data.Components {
+			row, ok := component.(*discordgo.ActionsRow)
+			if !ok {
+				continue
+			}
+			for _, c := range row.Components {
+				if input, ok := c.(*discordgo.TextInput); ok {
+					values[input.CustomID] = input.Value
+				}
+			}
+		}
+		in.Data = &interactionv1.Interaction_Modal{
+			Modal: &interactionv1.ModalSubmitData{
+				CustomId: data.CustomID,
+				Values:   values,
+			},
+		}
+	}
+	return in
+}
+
+func toProtoOptions(options []*discordgo.ApplicationCommandInteractionDataOption) []*interactionv1.CommandOption {
+	out := make([]*interactionv1.CommandOption, len(options))
+	for i, option := range options {
+		o := &interactionv1.CommandOption{
+			Name:    option.Name,
+			Type:    int32(option.Type),
+			Options: toProtoOptions(option.Options),
+			Focused: option.Focused,
+		}
+
+		switch value := option.Value.(type) {
+		case string:
+			o.Value = &interactionv1.CommandOption_StringValue{StringValue: value}
+		case bool:
+			o.Value = &interactionv1.CommandOption_BoolValue{BoolValue: value}
+		case float64:
+			if option.Type == discordgo.ApplicationCommandOptionInteger {
+				o.Value = &interactionv1.CommandOption_IntValue{IntValue: int64(value)}
+			} else {
+				o.Value = &interactionv1.CommandOption_NumberValue{NumberValue: value}
+			}
+		}
+		out[i] = o
+	}
+	return out
+}
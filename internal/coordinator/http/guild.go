@@ -0,0 +1,44 @@
+package http
+
+import (
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"slices"
+)
+
+// matchesGuildRoute checks whether the guild is one of the route's guilds.
+func matchesGuildRoute(log *slog.Logger, namespace string, route powergridv10.GuildRoute, guildID string) bool {
+	if slices.Contains(route.GuildIDs, guildID) {
+		return true
+	}
+	if route.GuildIDsFrom == nil {
+		return false
+	}
+
+	value, exists, err := kubernetes.GetConfigMapValue(namespace, route.GuildIDsFrom.Name, route.GuildIDsFrom.Key)
+	if err != nil || !exists {
+		log.Error("failed to get guild ids from configmap",
+			utils.Tag("guild_route_configmap_failed"),
+			utils.Error(err),
+			slog.String("configmap", route.GuildIDsFrom.Name),
+			slog.String("key", route.GuildIDsFrom.Key))
+		return false
+	}
+	return slices.Contains(routing.GuildIDs(value), guildID)
+}
+
+// guildService returns the service of the first guild route matching the guild, or an empty string.
+func guildService(log *slog.Logger, namespace string, routes []powergridv10.GuildRoute, guildID string) string {
+	if guildID == "" {
+		return ""
+	}
+	for _, route := range routes {
+		if matchesGuildRoute(log, namespace, route, guildID) {
+			return route.ServiceName
+		}
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"net/http"
+)
+
+// HandleReady passes once the command and service informers have synced and the Discord session is valid,
+// so that interactions are not rejected as unknown commands while the stores are still empty.
+// Fails while draining.
+func HandleReady(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", utils.MimeTypeText)
+
+	if draining.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("draining"))
+		return
+	}
+	if err := kubernetes.Synced(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	if !discord.SessionValid() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("discord session not valid"))
+		return
+	}
+
+	role := "follower"
+	if kubernetes.IsLeader() {
+		role = "leader"
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n" + role))
+}
+
+// HandleLive fails if an informer is stuck, so that the coordinator is restarted.
+func HandleLive(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", utils.MimeTypeText)
+
+	if err := kubernetes.Live(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
@@ -4,14 +4,20 @@ import (
 	"bytes"
 	"context"
 	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/audit"
 	"github.com/sportshead/powergrid/internal/coordinator/env"
 	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/queue"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
 	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/powergrid"
 	"github.com/sportshead/powergrid/pkg/utils"
 	"io"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"log/slog"
 	"net/http"
-	"strings"
 )
 
 func HandleHTTP(w http.ResponseWriter, r *http.Request) {
@@ -47,6 +53,7 @@ func HandleHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	log := slog.With(slog.String("id", interaction.ID))
+	defer track(interaction.ID)()
 
 	switch interaction.Type {
 	case discordgo.InteractionPing:
@@ -67,31 +74,128 @@ func HandleHTTP(w http.ResponseWriter, r *http.Request) {
 		cmd, err = kubernetes.GetCommand(data.Name)
 		if err != nil {
 			log.Error("failed to get handler for command", utils.Tag("unknown_command"), utils.Error(err), slog.String("body", string(body)))
+			kubernetes.Recorder.Eventf(kubernetes.PodReference(), corev1.EventTypeWarning, kubernetes.ReasonUnknownCommand, "No Command object for command %q: %v", data.Name, err)
 			writeMessage(w, MissingHandlerMessage)
 			return
 		}
 
-		addr := kubernetes.GetServiceAddr(log, cmd.Spec.ServiceName)
-		if addr == "" {
-			log.Error("failed to get service address", utils.Tag("failed_get_service_address"))
+		startAudit(log, cmd, interaction)
 
-			writeMessage(w, MissingServiceMessage)
+		if !checkAccess(log, w, interaction, cmd.Namespace, cmd.Spec.AccessPolicies) {
+			setAuditOutcome(interaction.ID, audit.OutcomeDenied)
 			return
 		}
+		if !checkEntitlement(log, w, body, interaction, cmd.Spec.RequiredSKUs) {
+			setAuditOutcome(interaction.ID, audit.OutcomeDenied)
+			return
+		}
+		if writeCachedAutocomplete(log, w, cmd, interaction) {
+			return
+		}
+		if interaction.Type == discordgo.InteractionApplicationCommand && !checkRateLimits(log, w, interaction, cmd) {
+			setAuditOutcome(interaction.ID, audit.OutcomeRateLimited)
+			return
+		}
+		if cmd.Spec.Response != nil {
+			writeStaticResponse(log, w, cmd, interaction)
+			return
+		}
+
+		var req *http.Request
+		var addr string
+		var target *kubernetes.ScaleTarget
+		var external *powergridv10.Command
+		transport := cmd.Spec.Transport
+		service := guildService(log, cmd.Namespace, cmd.Spec.GuildRoutes, interaction.GuildID)
+		if service == "" && cmd.Spec.External != nil {
+			log = log.With(slog.String("url", cmd.Spec.External.URL))
+			req, err = makeExternalRequest(r.Context(), r, cmd.Namespace, cmd.Spec.External, body)
+			if err != nil {
+				log.Error("failed to make external request", utils.Tag("failed_external_request"), utils.Error(err))
+				setAuditOutcome(interaction.ID, audit.OutcomeFailed)
+
+				writeMessage(w, MissingServiceMessage)
+				return
+			}
+			service = req.URL.Host
+			transport = powergridv10.TransportHTTP
+			external = cmd
+		} else {
+			if service == "" {
+				service = routing.SelectService(cmd, interaction)
+			}
+			log = log.With(slog.String("service", service))
 
-		log = log.With(slog.String("addr", addr))
+			var scheme string
+			addr, scheme = kubernetes.GetServiceAddr(log, cmd, cmd.Namespace, service, cmd.Spec.Port)
+			if addr == "" {
+				log.Error("failed to get service address", utils.Tag("failed_get_service_address"))
+				setAuditOutcome(interaction.ID, audit.OutcomeFailed)
 
-		req := makeRequest(r, addr, body)
+				writeMessage(w, MissingServiceMessage)
+				return
+			}
+
+			log = log.With(slog.String("addr", addr))
+
+			if scheme == kubernetes.SchemeGRPC {
+				if transport == "" {
+					transport = powergridv10.TransportGRPC
+				}
+				scheme = "http"
+			}
+
+			req = makeRequest(r, addr, body)
+			req.URL.Scheme = scheme
+			req.URL.Path = routing.CommandPath(cmd.Spec.Path, data.Name)
+			target = checkActivation(log, cmd.Namespace, service)
+		}
+		grpcStream := transport == powergridv10.TransportGRPCStream
+		useGRPC := grpcStream || transport == powergridv10.TransportGRPC
 
 		var shouldDefer bool
 		shouldDefer = cmd.Spec.ShouldSendDeferred && interaction.Type != discordgo.InteractionApplicationCommandAutocomplete
 		log = log.With(slog.Bool("deferred", shouldDefer))
+		if shouldDefer && req != nil {
+			req.Header.Set(powergrid.HeaderDeferred, "true")
+		}
+		if target != nil {
+			activateAndForward(log, w, interaction, target, func(ctx context.Context) {
+				switch {
+				case useGRPC:
+					forwardGRPC(log, w, addr, true, grpcStream, interaction, body, data.Name, service)
+				case shouldDefer:
+					forwardInteraction(log, w, req, shouldDefer, interaction, data.Name, service)
+				default:
+					forwardActivated(ctx, log, req, interaction, data.Name, service)
+				}
+			})
+			return
+		}
+		if useGRPC {
+			if shouldDefer {
+				utils.WriteJSONString(w, InteractionResponseDeferredChannelMessageWithSourceJSON)
+				goTracked(interaction.ID, func() {
+					forwardGRPC(log, w, addr, shouldDefer, grpcStream, interaction, body, data.Name, service)
+				})
+				return
+			}
+			forwardGRPC(log, w, addr, shouldDefer, grpcStream, interaction, body, data.Name, service)
+			return
+		}
 		if shouldDefer {
+			// the interaction is only acknowledged once it is durable, and otherwise delivered directly
+			if queue.Enabled() && enqueueDelivery(log, req, body, interaction, data.Name, service, external) {
+				utils.WriteJSONString(w, InteractionResponseDeferredChannelMessageWithSourceJSON)
+				return
+			}
 			utils.WriteJSONString(w, InteractionResponseDeferredChannelMessageWithSourceJSON)
-			go forwardInteraction(log, w, req, shouldDefer, interaction)
+			goTracked(interaction.ID, func() {
+				forwardInteraction(log, w, req, shouldDefer, interaction, data.Name, service)
+			})
 			return
 		}
-		forwardInteraction(log, w, req, shouldDefer, interaction)
+		forwardInteraction(log, w, req, shouldDefer, interaction, data.Name, service)
 
 	case discordgo.InteractionMessageComponent:
 		data := interaction.Data.(discordgo.MessageComponentInteractionData)
@@ -120,10 +224,56 @@ func HandleHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func handleMessageOrModal(log *slog.Logger, w http.ResponseWriter, r *http.Request, body []byte, interaction *discordgo.Interaction, id string) {
-	service := strings.Split(id, "/")[0]
+	prefix := powergrid.ComponentPrefix(id)
+	service := prefix
+	// without a component route, the service is in the coordinator's namespace
+	var namespace string
+	var port *intstr.IntOrString
+	var path string
+
+	route, err := kubernetes.GetComponentRoute(prefix)
+	if err != nil {
+		log.Error("failed to get component route", utils.Tag("component_route_failed"), utils.Error(err), slog.String("prefix", prefix))
+		writeMessage(w, MissingServiceMessage)
+		return
+	}
+	if route != nil {
+		log = log.With(slog.String("route", route.Name))
+		namespace = route.Namespace
+		port = route.Spec.Port
+		path = route.Spec.Path
+		service = guildService(log, route.Namespace, route.Spec.GuildRoutes, interaction.GuildID)
+
+		if !checkAccess(log, w, interaction, route.Namespace, route.Spec.AccessPolicies) {
+			return
+		}
+		if !checkEntitlement(log, w, body, interaction, route.Spec.RequiredSKUs) {
+			return
+		}
+
+		if service == "" && route.Spec.External != nil {
+			log = log.With(slog.String("url", route.Spec.External.URL))
+			req, err := makeExternalRequest(r.Context(), r, route.Namespace, route.Spec.External, body)
+			if err != nil {
+				log.Error("failed to make external request", utils.Tag("failed_external_request"), utils.Error(err))
+
+				writeMessage(w, MissingServiceMessage)
+				return
+			}
+			forwardInteraction(log, w, req, false, interaction, prefix, req.URL.Host)
+			return
+		}
+		if service == "" {
+			service = route.Spec.ServiceName
+		}
+	}
 	log = log.With(slog.String("service", service))
 
-	addr := kubernetes.GetServiceAddr(log, service)
+	var referrer runtime.Object
+	if route != nil {
+		referrer = route
+	}
+	addr, scheme := kubernetes.GetServiceAddr(log, referrer, namespace, service, port)
 	if addr == "" {
 		log.Error("failed to get service address", utils.Tag("failed_get_service_address"))
 
@@ -133,8 +283,40 @@ func handleMessageOrModal(log *slog.Logger, w http.ResponseWriter, r *http.Reque
 
 	log = log.With(slog.String("addr", addr))
 
+	if scheme == kubernetes.SchemeGRPC {
+		if target := checkActivation(log, namespace, service); target != nil {
+			activateAndForward(log, w, interaction, target, func(ctx context.Context) {
+				forwardGRPC(log, w, addr, true, false, interaction, body, prefix, service)
+			})
+			return
+		}
+		forwardGRPC(log, w, addr, false, false, interaction, body, prefix, service)
+		return
+	}
+
 	req := makeRequest(r, addr, body)
-	forwardInteraction(log, w, req, false, interaction)
+	req.URL.Scheme = scheme
+	req.URL.Path = routing.ComponentPath(path, prefix)
+	if target := checkActivation(log, namespace, service); target != nil {
+		activateAndForward(log, w, interaction, target, func(ctx context.Context) {
+			forwardActivated(ctx, log, req, interaction, prefix, service)
+		})
+		return
+	}
+	forwardInteraction(log, w, req, false, interaction, prefix, service)
+}
+
+// checkEntitlement checks that the interaction is entitled to one of the SKUs, otherwise responding with a premium required message.
+func checkEntitlement(log *slog.Logger, w http.ResponseWriter, body []byte, interaction *discordgo.Interaction, skus []string) bool {
+	ok, err := routing.HasEntitlement(body, skus)
+	if err != nil {
+		log.Error("failed to parse entitlements", utils.Tag("failed_parse_entitlements"), utils.Error(err))
+	}
+	if !ok {
+		log.Info("interaction is missing entitlement", utils.Tag("entitlement_missing"), slog.Any("skus", skus))
+		writePremiumRequired(w, interaction, skus)
+	}
+	return ok
 }
 
 func makeRequest(r *http.Request, addr string, body []byte) *http.Request {
@@ -143,6 +325,10 @@ func makeRequest(r *http.Request, addr string, body []byte) *http.Request {
 	req.URL.Host = addr
 	req.URL.Path = "/"
 	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
 	req.RequestURI = ""
 	return req
 }
@@ -1,14 +1,19 @@
 package http
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/capture"
 	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
 	"github.com/sportshead/powergrid/pkg/utils"
 	"github.com/sportshead/powergrid/pkg/version"
 	"io"
 	"log/slog"
 	"net/http"
+	"time"
 )
 
 const (
@@ -18,24 +23,49 @@ const (
 	InteractionResponseDeferredChannelMessageWithSourceJSON = `{"type":5}`
 )
 
-func forwardInteraction(log *slog.Logger, w http.ResponseWriter, req *http.Request, shouldDefer bool, interaction *discordgo.Interaction) {
-	res, err := http.DefaultClient.Do(req)
+// maxLoggedResponse is the maximum length of an upstream error response which is logged.
+const maxLoggedResponse = 4 << 10
+
+// responseTimeout is how long Discord waits for the response to an interaction which is not deferred.
+const responseTimeout = 3 * time.Second
+
+// forwardInteraction sends the request to the service, and writes its response to Discord.
+// route is the command name or custom_id prefix, and is only used for metrics.
+// The request times out once Discord stops waiting for the response, or the interaction token expires if deferred, as external backends could hang forever.
+func forwardInteraction(log *slog.Logger, w http.ResponseWriter, req *http.Request, shouldDefer bool, interaction *discordgo.Interaction, route string, service string) {
+	timeout := responseTimeout
+	if shouldDefer {
+		timeout = interactionTokenLifetime
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	res, err := serviceClient.Do(req)
+	metrics.ForwardDuration.WithLabelValues(route, service).Observe(time.Since(start).Seconds())
 	if err != nil {
+		observeForward(interaction.ID, route, service, metrics.ResultFailed)
+		captureForward(log, req, interaction, route, service, start, 0, nil, err)
 		log.Error("failed to forward request", utils.Tag("failed_forward_request"), utils.Error(err), slog.String("interaction", utils.TryMarshal(interaction)))
 		if !shouldDefer {
 			writeMessage(w, ForwardFailedMessage)
 		}
 		return
 	}
+	defer res.Body.Close()
 	log = log.With(
 		slog.Int("status", res.StatusCode),
 		slog.String("status_text", res.Status),
 	)
 	if res.StatusCode != http.StatusOK {
+		observeForward(interaction.ID, route, service, metrics.ResultUpstreamError)
+		resp, _ := io.ReadAll(io.LimitReader(res.Body, maxLoggedResponse))
+		captureForward(log, req, interaction, route, service, start, res.StatusCode, resp, nil)
 		log.Error("upstream returned error",
 			utils.Tag("upstream_error"),
 			slog.String("interaction", utils.TryMarshal(interaction)),
-			slog.String("response", fmt.Sprint(io.ReadAll(req.Body))),
+			slog.String("response", string(resp)),
 		)
 		if !shouldDefer {
 			writeMessage(w, fmt.Sprintf(UpstreamErrorMessage, res.StatusCode, res.Status))
@@ -49,6 +79,7 @@ func forwardInteraction(log *slog.Logger, w http.ResponseWriter, req *http.Reque
 		}
 		return
 	}
+	observeForward(interaction.ID, route, service, metrics.ResultOK)
 
 	if !shouldDefer {
 		contentType := res.Header.Get("Content-Type")
@@ -57,18 +88,25 @@ func forwardInteraction(log *slog.Logger, w http.ResponseWriter, req *http.Reque
 		}
 		w.Header().Set("Content-Type", contentType)
 		w.WriteHeader(http.StatusOK)
+
+		body := io.Reader(res.Body)
+		resp := &bytes.Buffer{}
+		if version.Debug || capture.Enabled() || cachingAutocomplete(interaction.ID) {
+			body = io.TeeReader(res.Body, resp)
+		}
+		_, err = io.Copy(w, body)
 		if version.Debug {
-			r := io.TeeReader(res.Body, w)
-			resp, _ := io.ReadAll(r)
-			log.Debug("response", slog.String("ctype", contentType), slog.String("response", string(resp)))
-		} else {
-			_, err = io.Copy(w, res.Body)
+			log.Debug("response", slog.String("ctype", contentType), slog.String("response", resp.String()))
 		}
+		captureForward(log, req, interaction, route, service, start, res.StatusCode, resp.Bytes(), err)
 
 		if err != nil {
 			log.Error("failed to copy response body", utils.Tag("failed_write_body"), utils.Error(err), slog.String("interaction", utils.TryMarshal(interaction)))
 			return
 		}
+		cacheAutocomplete(log, interaction.ID, res.Header.Get("Cache-Control"), resp.Bytes())
+	} else {
+		captureForward(log, req, interaction, route, service, start, res.StatusCode, nil, nil)
 	}
 
 	log.Info("handled interaction", utils.Tag("interaction_handled"))
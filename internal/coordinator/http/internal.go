@@ -0,0 +1,38 @@
+package http
+
+import (
+	"errors"
+	"github.com/sportshead/powergrid/internal/coordinator/linkedroles"
+	"github.com/sportshead/powergrid/internal/coordinator/metrics"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"github.com/sportshead/powergrid/pkg/version"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+// initInternal starts the internal API server, which is only exposed to services inside the cluster.
+func initInternal(stop *utils.Stopper, cleanupGroup *sync.WaitGroup) *http.Server {
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc(linkedroles.RoleConnectionPath, linkedroles.HandleRoleConnection)
+	serveMux.HandleFunc(powergrid.WebhookPath, HandleWebhook)
+	serveMux.Handle("/metrics", metrics.Handler())
+
+	server := &http.Server{
+		Addr:    "0.0.0.0:8001",
+		Handler: version.Middleware("coordinator", serveMux),
+	}
+
+	cleanupGroup.Add(1)
+	go func() {
+		defer cleanupGroup.Done()
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("internal http server died", utils.Tag("http_internal_died"), utils.Error(err))
+			stop.Stop()
+		}
+	}()
+
+	slog.Info("internal http server listening", utils.Tag("http_internal_listen"), slog.String("addr", server.Addr))
+	return server
+}
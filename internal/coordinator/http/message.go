@@ -9,19 +9,19 @@ import (
 )
 
 const (
-	MissingHandlerMessage = "**Error**: Unknown command"
-	MissingServiceMessage = "**Error**: Failed to get service address"
-	ForwardFailedMessage  = "**Error**: Failed to forward request"
-	UpstreamErrorMessage  = "**Error**: Upstream server returned error `%d`: `%s`"
+	MissingHandlerMessage       = "**Error**: Unknown command"
+	MissingServiceMessage       = "**Error**: Failed to get service address"
+	ForwardFailedMessage        = "**Error**: Failed to forward request"
+	UpstreamErrorMessage        = "**Error**: Upstream server returned error `%d`: `%s`"
+	PremiumRequiredMessage      = "**Error**: This requires a premium subscription"
+	RateLimitedMessage          = "**Slow down!** You can use this command again <t:%d:R>"
+	AccessDeniedMessage         = "**Error**: You do not have permission to use this"
+	StaticResponseFailedMessage = "**Error**: Failed to render response"
 )
 
-func writeMessage(w http.ResponseWriter, message string) {
-	w.Header().Set("Content-Type", utils.MimeTypeJSON)
-	w.WriteHeader(http.StatusOK)
-
-	encoder := json.NewEncoder(w)
-
-	res := &discordgo.InteractionResponse{
+// messageResponse returns an ephemeral message response, without any mentions.
+func messageResponse(message string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
 			Content: message,
@@ -31,10 +31,35 @@ func writeMessage(w http.ResponseWriter, message string) {
 			},
 		},
 	}
+}
+
+func writeMessage(w http.ResponseWriter, message string) {
+	writeResponse(w, messageResponse(message))
+}
+
+// writeDenied responds with an ephemeral message. Autocomplete interactions can't be answered with a message, so they receive no choices instead.
+func writeDenied(w http.ResponseWriter, interaction *discordgo.Interaction, message string) {
+	if interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		writeResponse(w, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+			Data: &discordgo.InteractionResponseData{
+				Choices: []*discordgo.ApplicationCommandOptionChoice{},
+			},
+		})
+		return
+	}
+	writeMessage(w, message)
+}
+
+func writeResponse(w http.ResponseWriter, res *discordgo.InteractionResponse) {
+	w.Header().Set("Content-Type", utils.MimeTypeJSON)
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
 
 	err := encoder.Encode(res)
 	if err != nil {
-		slog.Error("failed to write message", utils.Tag("failed_write_message"), utils.Error(err), slog.String("message", message))
+		slog.Error("failed to write message", utils.Tag("failed_write_message"), utils.Error(err), slog.String("response", utils.TryMarshal(res)))
 		return
 	}
 }
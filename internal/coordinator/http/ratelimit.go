@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/ratelimit"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// rateLimitKey returns the bucket key for the limit, or an empty string if the limit does not apply to the interaction.
+func rateLimitKey(cmd *powergridv10.Command, limit powergridv10.RateLimit, interaction *discordgo.Interaction) string {
+	var id string
+	switch limit.Scope {
+	case powergridv10.RateLimitScopeUser:
+		id = routing.UserID(interaction)
+	case powergridv10.RateLimitScopeGuild:
+		id = interaction.GuildID
+	case powergridv10.RateLimitScopeChannel:
+		id = interaction.ChannelID
+	}
+	if id == "" {
+		return ""
+	}
+
+	// the Command is a hash tag, so its buckets are in the same slot of a cluster and can be taken together
+	return fmt.Sprintf("powergrid:ratelimit:{%s/%s}:%s:%d/%s:%s", cmd.Namespace, cmd.Name, limit.Scope, limit.Limit, limit.Period.Duration, id)
+}
+
+// checkRateLimits takes a token from each of the command's rate limits, otherwise responding with a rate limited message.
+// Tokens are only taken if every limit allows the interaction. Limits are not enforced if the store fails.
+func checkRateLimits(log *slog.Logger, w http.ResponseWriter, interaction *discordgo.Interaction, cmd *powergridv10.Command) bool {
+	var buckets []ratelimit.Bucket
+	for _, limit := range cmd.Spec.RateLimits {
+		key := rateLimitKey(cmd, limit, interaction)
+		if key == "" || limit.Limit <= 0 || limit.Period.Duration <= 0 {
+			continue
+		}
+		buckets = append(buckets, ratelimit.Bucket{Key: key, Limit: int(limit.Limit), Period: limit.Period.Duration})
+	}
+	if len(buckets) == 0 {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	wait, err := ratelimit.Take(ctx, buckets)
+	cancel()
+	if err != nil {
+		log.Error("failed to check rate limits", utils.Tag("ratelimit_failed"), utils.Error(err))
+		return true
+	}
+	if wait > 0 {
+		log.Info("interaction rate limited", utils.Tag("ratelimited"), slog.Duration("wait", wait))
+		writeMessage(w, fmt.Sprintf(RateLimitedMessage, time.Now().Add(wait).Unix()+1))
+		return false
+	}
+	return true
+}
@@ -2,6 +2,8 @@ package http
 
 import (
 	"errors"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"github.com/sportshead/powergrid/internal/coordinator/linkedroles"
 	"github.com/sportshead/powergrid/pkg/utils"
 	"github.com/sportshead/powergrid/pkg/version"
 	"log/slog"
@@ -9,15 +11,24 @@ import (
 	"sync"
 )
 
-func Init(stop chan struct{}, cleanupGroup *sync.WaitGroup) {
+func Init(stop *utils.Stopper, cleanupGroup *sync.WaitGroup) {
+	kubernetes.OnServiceAddrsRemoved(closeGRPCConns)
+
 	serveMux := http.NewServeMux()
 	serveMux.HandleFunc("/", HandleHTTP)
+	serveMux.HandleFunc(EventsPath, HandleEvents)
 	serveMux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", utils.MimeTypeText)
 
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok\nrunning " + version.String))
 	})
+	serveMux.HandleFunc("/readyz", HandleReady)
+	serveMux.HandleFunc("/livez", HandleLive)
+	if linkedroles.Enabled() {
+		serveMux.HandleFunc("/linked-roles", linkedroles.HandleVerify)
+		serveMux.HandleFunc("/linked-roles/callback", linkedroles.HandleCallback)
+	}
 
 	server := &http.Server{
 		Addr:    "0.0.0.0:8000",
@@ -29,9 +40,22 @@ func Init(stop chan struct{}, cleanupGroup *sync.WaitGroup) {
 		defer cleanupGroup.Done()
 		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
 			slog.Error("http server died", utils.Tag("http_died"), utils.Error(err))
-			close(stop)
+			stop.Stop()
 		}
 	}()
 
 	slog.Info("http server listening", utils.Tag("http_listen"), slog.String("addr", server.Addr))
+
+	servers := []*http.Server{server, initInternal(stop, cleanupGroup)}
+	if adminServer := initAdmin(stop, cleanupGroup); adminServer != nil {
+		servers = append(servers, adminServer)
+	}
+	startDeliveries(stop, cleanupGroup)
+
+	cleanupGroup.Add(1)
+	go func() {
+		defer cleanupGroup.Done()
+		<-stop.Done()
+		drain(servers...)
+	}()
 }
@@ -0,0 +1,41 @@
+package http
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/audit"
+	"github.com/sportshead/powergrid/internal/coordinator/static"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+)
+
+// writeStaticResponse answers the interaction with the Command's inline response, rendering its templates.
+func writeStaticResponse(log *slog.Logger, w http.ResponseWriter, cmd *powergridv10.Command, interaction *discordgo.Interaction) {
+	// there is no service to suggest choices
+	if interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		writeDenied(w, interaction, "")
+		return
+	}
+
+	templates, err := static.Get(cmd)
+	var res []byte
+	if err == nil {
+		res, err = templates.Render(interaction)
+	}
+	if err != nil {
+		log.Error("failed to render static response", utils.Tag("static_response_failed"), utils.Error(err))
+		setAuditOutcome(interaction.ID, audit.OutcomeFailed)
+		writeMessage(w, StaticResponseFailedMessage)
+		return
+	}
+	setAuditOutcome(interaction.ID, audit.OutcomeOK)
+
+	w.Header().Set("Content-Type", utils.MimeTypeJSON)
+	_, err = w.Write(res)
+	if err != nil {
+		log.Error("failed to write static response", utils.Tag("failed_write_body"), utils.Error(err))
+		return
+	}
+	log.Info("responded with static response", utils.Tag("static_response"))
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"github.com/sportshead/powergrid/internal/coordinator/kubernetes"
+	"net/http"
+	"sync"
+)
+
+var errInvalidCABundle = errors.New("ca bundle contains no certificates")
+
+// serviceClient sends requests to services and external backends.
+// https services are verified with their CA bundle, see kubernetes.AnnotationCABundle, and external backends are sent by externalTransport.
+var serviceClient = &http.Client{Transport: &serviceTransport{transports: make(map[string]*http.Transport)}}
+
+// serviceTransport uses a transport for each CA bundle, so connections are only reused with the same roots.
+type serviceTransport struct {
+	mutex      sync.Mutex
+	transports map[string]*http.Transport
+}
+
+func (t *serviceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isExternal(req) {
+		// only addresses returned by kubernetes.GetServiceAddr are verified with a service's CA bundle
+		return externalTransport.RoundTrip(req)
+	}
+	if req.URL.Scheme != "https" {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	bundle, err := kubernetes.GetServiceCABundle(req.URL.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	if bundle == nil {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+
+	t.mutex.Lock()
+	transport, ok := t.transports[string(bundle)]
+	if !ok {
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(bundle) {
+			t.mutex.Unlock()
+			return nil, errInvalidCABundle
+		}
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: roots}
+		t.transports[string(bundle)] = transport
+	}
+	t.mutex.Unlock()
+
+	return transport.RoundTrip(req)
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// editOriginalResponse replaces the deferred response to the interaction with a message.
+// data is the JSON of the message, which is passed on as is since discordgo can't unmarshal components.
+func editOriginalResponse(interaction *discordgo.Interaction, data json.RawMessage) error {
+	endpoint := discordgo.EndpointWebhookMessage(interaction.AppID, interaction.Token, "@original")
+	_, err := discord.Session.RequestWithBucketID(http.MethodPatch, endpoint, data, discordgo.EndpointWebhookToken("", ""))
+	return err
+}
+
+// createFollowup sends a follow up message to the interaction, with data as the JSON of the message.
+func createFollowup(interaction *discordgo.Interaction, data json.RawMessage) error {
+	endpoint := discordgo.EndpointWebhookToken(interaction.AppID, interaction.Token) + "?wait=true"
+	_, err := discord.Session.RequestWithBucketID(http.MethodPost, endpoint, data, discordgo.EndpointWebhookToken("", ""))
+	return err
+}
+
+// webhookTokenPattern matches interaction tokens, so that they can't change the path of the endpoint.
+var webhookTokenPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// webhookMessagePattern matches the IDs of interaction webhook messages.
+var webhookMessagePattern = regexp.MustCompile(`^(@original|[0-9]+)$`)
+
+// maxWebhookBody is the largest request body relayed to Discord.
+const maxWebhookBody = 1 << 20
+
+// HandleWebhook relays interaction webhook requests from services to Discord, through the coordinator's rate limiter.
+// See powergrid.WebhookPath for the paths. Discord's response is passed back as is.
+func HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	token, message, hasMessage := strings.Cut(strings.TrimPrefix(r.URL.Path, powergrid.WebhookPath), "/")
+	if !webhookTokenPattern.MatchString(token) {
+		http.Error(w, "invalid interaction token", http.StatusNotFound)
+		return
+	}
+
+	var endpoint string
+	var allowed string
+	if hasMessage {
+		id, ok := strings.CutPrefix(message, "messages/")
+		if !ok || !webhookMessagePattern.MatchString(id) {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		endpoint = discordgo.EndpointWebhookMessage(env.DiscordApplicationID, token, id)
+		allowed = "GET, PATCH, DELETE"
+	} else {
+		endpoint = discordgo.EndpointWebhookToken(env.DiscordApplicationID, token) + "?wait=true"
+		allowed = "POST"
+	}
+	if !slices.Contains(strings.Split(allowed, ", "), r.Method) {
+		w.Header().Set("Allow", allowed)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var data interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody+1))
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxWebhookBody || !json.Valid(body) {
+			http.Error(w, "request body must be a json message", http.StatusBadRequest)
+			return
+		}
+		data = json.RawMessage(body)
+	}
+
+	log := slog.With(slog.String("method", r.Method), slog.String("message", message), slog.String("ip", utils.GetIP(r)))
+	response, err := discord.Session.RequestWithBucketID(r.Method, endpoint, data, discordgo.EndpointWebhookToken("", ""), discordgo.WithContext(r.Context()))
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Response != nil {
+		log.Warn("discord rejected relayed webhook request", utils.Tag("webhook_relay_rejected"), slog.Int("status", restErr.Response.StatusCode), slog.String("body", string(restErr.ResponseBody)))
+		w.Header().Set("Content-Type", utils.MimeTypeJSON)
+		w.WriteHeader(restErr.Response.StatusCode)
+		_, _ = w.Write(restErr.ResponseBody)
+		return
+	}
+	if err != nil {
+		log.Error("failed to relay webhook request", utils.Tag("webhook_relay_failed"), utils.Error(err))
+		http.Error(w, "failed to relay webhook request", http.StatusBadGateway)
+		return
+	}
+
+	log.Debug("relayed webhook request", utils.Tag("webhook_relayed"))
+	if len(response) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", utils.MimeTypeJSON)
+	_, _ = w.Write(response)
+}
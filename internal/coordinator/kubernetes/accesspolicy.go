@@ -0,0 +1,30 @@
+package kubernetes
+
+import (
+	"fmt"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	informers "github.com/sportshead/powergrid/pkg/generated/informers/externalversions"
+	"k8s.io/client-go/tools/cache"
+)
+
+var accessPolicyInformer *namespacedInformer
+
+func loadAccessPolicies(factories []informers.SharedInformerFactory) {
+	accessPolicyInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Powergrid().V10().AccessPolicies().Informer()
+	})
+	accessPolicyHealth.track(accessPolicyInformer.informers...)
+}
+
+// GetAccessPolicy returns the AccessPolicy with the name in the namespace.
+func GetAccessPolicy(namespace, name string) (*powergridv10.AccessPolicy, error) {
+	obj, exists, err := accessPolicyInformer.GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("access policy %s/%s does not exist", namespace, name)
+	}
+
+	return obj.(*powergridv10.AccessPolicy), nil
+}
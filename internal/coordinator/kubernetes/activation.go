@@ -0,0 +1,236 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"github.com/sportshead/powergrid/pkg/utils"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// AnnotationScaleTarget is set on a Service to the name of the Deployment backing it, to scale the Deployment to zero replicas when idle.
+	AnnotationScaleTarget = "powergrid.sportshead.dev/scale-target"
+	// AnnotationIdleTimeout is set on a Service to how long it must be unused before its scale target is scaled down, e.g. 30m. Defaults to DefaultIdleTimeout.
+	AnnotationIdleTimeout = "powergrid.sportshead.dev/idle-timeout"
+	// AnnotationLastActivity is set on scale targets by the coordinator to the time of the last interaction, shared between replicas.
+	AnnotationLastActivity = "powergrid.sportshead.dev/last-activity"
+)
+
+const DefaultIdleTimeout = 15 * time.Minute
+
+// activityInterval is the minimum time between updates to AnnotationLastActivity by each replica.
+const activityInterval = time.Minute
+
+// activationTimeout is how long to wait for a scaled up service to become ready.
+const activationTimeout = 5 * time.Minute
+
+const ByService = "byService"
+
+var endpointSliceInformer *namespacedInformer
+
+var lastActivity = make(map[string]time.Time)
+var lastActivityMutex sync.Mutex
+
+func loadEndpointSlices(factories []informers.SharedInformerFactory) {
+	endpointSliceInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Discovery().V1().EndpointSlices().Informer()
+	})
+	endpointSliceHealth.track(endpointSliceInformer.informers...)
+	err := endpointSliceInformer.AddIndexers(cache.Indexers{
+		ByService: func(obj interface{}) ([]string, error) {
+			slice := obj.(*discoveryv1.EndpointSlice)
+			serviceName, ok := slice.Labels[discoveryv1.LabelServiceName]
+			if !ok {
+				return nil, nil
+			}
+			return []string{slice.Namespace + "/" + serviceName}, nil
+		},
+	})
+	if err != nil {
+		slog.Error("failed to add indexer", utils.Tag("k8s_indexer_failed"), utils.Error(err))
+		os.Exit(1)
+	}
+}
+
+// ScaleTarget is a Deployment which is scaled to zero replicas while its Service is idle.
+type ScaleTarget struct {
+	Namespace  string
+	Service    string
+	Deployment string
+}
+
+// GetScaleTarget returns the scale target of a reference to a service, or nil if the service is not annotated with one.
+func GetScaleTarget(from string, ref string) *ScaleTarget {
+	serviceNamespace, serviceName := ParseServiceRef(from, ref)
+	obj, exists, err := serviceInformer.GetByKey(serviceNamespace + "/" + serviceName)
+	if err != nil || !exists {
+		return nil
+	}
+
+	deployment, ok := obj.(*corev1.Service).Annotations[AnnotationScaleTarget]
+	if !ok || deployment == "" {
+		return nil
+	}
+	return &ScaleTarget{
+		Namespace:  serviceNamespace,
+		Service:    serviceName,
+		Deployment: deployment,
+	}
+}
+
+func (t *ScaleTarget) key() string {
+	return t.Namespace + "/" + t.Deployment
+}
+
+// Ready checks whether the service has any ready endpoints.
+func (t *ScaleTarget) Ready() bool {
+	return serviceReady(t.Namespace, t.Service)
+}
+
+func serviceReady(serviceNamespace string, serviceName string) bool {
+	endpointSlices, err := endpointSliceInformer.ByIndex(ByService, serviceNamespace+"/"+serviceName)
+	if err != nil {
+		return false
+	}
+	for _, obj := range endpointSlices {
+		for _, endpoint := range obj.(*discoveryv1.EndpointSlice).Endpoints {
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RecordActivity marks the scale target as used, so it is not scaled down.
+func (t *ScaleTarget) RecordActivity(log *slog.Logger) {
+	now := time.Now()
+
+	lastActivityMutex.Lock()
+	last := lastActivity[t.key()]
+	if now.Sub(last) < activityInterval {
+		lastActivityMutex.Unlock()
+		return
+	}
+	lastActivity[t.key()] = now
+	lastActivityMutex.Unlock()
+
+	go func() {
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, AnnotationLastActivity, now.Format(time.RFC3339))
+		_, err := kubernetesClient.AppsV1().Deployments(t.Namespace).Patch(context.Background(), t.Deployment, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			log.Error("failed to record activity", utils.Tag("k8s_activity_failed"), utils.Error(err), slog.String("deployment", t.key()))
+		}
+	}()
+}
+
+// Activate scales up the deployment if it has no replicas, and waits for the service to become ready.
+func (t *ScaleTarget) Activate(ctx context.Context, log *slog.Logger) error {
+	log = log.With(slog.String("deployment", t.key()))
+
+	err := t.scale(ctx, 0, 1)
+	if err != nil {
+		return err
+	}
+	log.Info("activating service", utils.Tag("k8s_activation_started"))
+
+	start := time.Now()
+	err = wait.PollUntilContextTimeout(ctx, 500*time.Millisecond, activationTimeout, true, func(context.Context) (bool, error) {
+		return t.Ready(), nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("activated service", utils.Tag("k8s_activation_finished"), slog.Duration("duration", time.Since(start)))
+	return nil
+}
+
+// scale sets the replicas of the deployment to the given number if it currently has from replicas.
+func (t *ScaleTarget) scale(ctx context.Context, from int32, to int32) error {
+	deployments := kubernetesClient.AppsV1().Deployments(t.Namespace)
+	scale, err := deployments.GetScale(ctx, t.Deployment, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if scale.Spec.Replicas != from {
+		return nil
+	}
+
+	_, err = deployments.UpdateScale(ctx, t.Deployment, &autoscalingv1.Scale{
+		ObjectMeta: scale.ObjectMeta,
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: to,
+		},
+	}, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		// scaled by another request or replica in the meantime
+		return nil
+	}
+	return err
+}
+
+// scaleDownIdle scales down the scale targets of services which have been idle for longer than their idle timeout.
+// Only run by the leader.
+func scaleDownIdle(ctx context.Context) {
+	for _, obj := range filterWatched(serviceInformer.List()) {
+		service := obj.(*corev1.Service)
+		deploymentName, ok := service.Annotations[AnnotationScaleTarget]
+		if !ok || deploymentName == "" {
+			continue
+		}
+		t := &ScaleTarget{
+			Namespace:  service.Namespace,
+			Service:    service.Name,
+			Deployment: deploymentName,
+		}
+		log := slog.With(slog.String("deployment", t.key()))
+
+		idleTimeout := DefaultIdleTimeout
+		if value, ok := service.Annotations[AnnotationIdleTimeout]; ok {
+			var err error
+			idleTimeout, err = time.ParseDuration(value)
+			if err != nil {
+				log.Error("failed to parse idle timeout", utils.Tag("k8s_idle_timeout_invalid"), utils.Error(err), slog.String("value", value))
+				continue
+			}
+		}
+
+		deployment, err := kubernetesClient.AppsV1().Deployments(t.Namespace).Get(ctx, t.Deployment, metav1.GetOptions{})
+		if err != nil {
+			log.Error("failed to get scale target", utils.Tag("k8s_scale_target_failed"), utils.Error(err))
+			continue
+		}
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas == 0 {
+			continue
+		}
+
+		last, err := time.Parse(time.RFC3339, deployment.Annotations[AnnotationLastActivity])
+		if err != nil {
+			// never used since the coordinator started managing it, so start the idle timeout from now
+			t.RecordActivity(log)
+			continue
+		}
+		if time.Since(last) < idleTimeout {
+			continue
+		}
+
+		err = t.scale(ctx, *deployment.Spec.Replicas, 0)
+		if err != nil {
+			log.Error("failed to scale down idle service", utils.Tag("k8s_scale_down_failed"), utils.Error(err))
+			continue
+		}
+		log.Info("scaled down idle service", utils.Tag("k8s_scaled_down"), slog.Time("last_activity", last))
+	}
+}
@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/static"
 	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
 	informers "github.com/sportshead/powergrid/pkg/generated/informers/externalversions"
 	"github.com/sportshead/powergrid/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"log/slog"
 	"os"
@@ -16,21 +20,37 @@ import (
 
 const ByName = "DiscordCommandNameIndexer"
 
-var commandInformer cache.SharedIndexInformer
+var commandInformer *namespacedInformer
 
 type commandObject struct {
 	Name string `json:"name"`
 }
 
 func updateCommands(ctx context.Context) {
-	list := commandInformer.GetStore().List()
+	list := filterWatched(commandInformer.List())
 
-	discord.UpdateCommands(ctx, list)
+	discord.UpdateCommands(ctx, list, Recorder, writeCommandStatus)
+	updateRoleConnectionMetadata(ctx)
+}
+
+// writeCommandStatus replaces the status of the Command.
+func writeCommandStatus(ctx context.Context, command *powergridv10.Command, status *powergridv10.CommandStatus) error {
+	patch, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return err
+	}
+	_, err = powergridClient.PowergridV10().Commands(command.Namespace).Patch(ctx, command.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	return err
 }
 
 func loadCommands() {
-	factory := informers.NewSharedInformerFactoryWithOptions(powergridClient, 10*time.Minute, informers.WithNamespace(namespace))
-	commandInformer = factory.Powergrid().V10().Commands().Informer()
+	var factories []informers.SharedInformerFactory
+	for _, ns := range informerNamespaces() {
+		factories = append(factories, informers.NewSharedInformerFactoryWithOptions(powergridClient, 10*time.Minute, informers.WithNamespace(ns)))
+	}
+	commandInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Powergrid().V10().Commands().Informer()
+	})
 	err := commandInformer.AddIndexers(map[string]cache.IndexFunc{
 		ByName: func(obj interface{}) ([]string, error) {
 			index := make([]string, 1)
@@ -51,18 +71,84 @@ func loadCommands() {
 		slog.Error("failed to add indexer", utils.Tag("k8s_indexer_failed"), utils.Error(err))
 		os.Exit(1)
 	}
+	err = commandInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: validateCommand,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// resyncs are sent as updates without changes, and status updates don't change the generation
+			if oldObj.(*powergridv10.Command).Generation != newObj.(*powergridv10.Command).Generation {
+				validateCommand(newObj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if command, ok := obj.(*powergridv10.Command); ok {
+				static.Forget(command)
+				discord.ForgetPermissions(command)
+			}
+		},
+	})
+	if err != nil {
+		slog.Error("failed to add event handler", utils.Tag("k8s_event_handler_failed"), utils.Error(err))
+		os.Exit(1)
+	}
+	commandHealth.track(commandInformer.informers...)
+	loadRoleConnectionMetadata(factories)
+	loadEventSubscriptions(factories)
+	loadComponentRoutes(factories)
+	loadAccessPolicies(factories)
+	loadReferenceGrants(factories)
 
-	factory.Start(stop)            // start goroutines
-	factory.WaitForCacheSync(stop) // wait for init
+	for _, factory := range factories {
+		factory.Start(stop) // start goroutines
+	}
+	for _, factory := range factories {
+		factory.WaitForCacheSync(stop) // wait for init
+	}
+	// Commands in selected namespaces are unregistered if they're filtered before the namespaces are synced
+	namespacesSynced()
 
 	startLeader()
 }
 
+// validateCommand records a warning on the Command if its command object or the templates of its response can't be parsed.
+// It is called when Commands are added or changed, instead of by the ByName index, which is recomputed on every resync.
+// The parsed templates are cached for the Command's generation, so they aren't parsed for every interaction.
+func validateCommand(obj interface{}) {
+	command := obj.(*powergridv10.Command)
+	err := json.Unmarshal(command.Spec.Command.Raw, &commandObject{})
+	if err != nil {
+		Recorder.Eventf(command, corev1.EventTypeWarning, discord.ReasonCommandParseFailed, "Failed to parse command: %v", err)
+	}
+
+	if command.Spec.Response != nil {
+		_, err = static.Get(command)
+		if err != nil {
+			slog.Error("failed to parse static response", utils.Tag("k8s_static_response_invalid"), utils.Error(err), slog.String("name", command.Name), slog.String("namespace", command.Namespace))
+			Recorder.Eventf(command, corev1.EventTypeWarning, ReasonStaticResponseInvalid, "Failed to parse response templates: %v", err)
+		}
+	}
+}
+
+// GetCommandByKey returns the Command with the namespace/name key.
+func GetCommandByKey(key string) (*powergridv10.Command, error) {
+	obj, exists, err := commandInformer.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("command %s does not exist", key)
+	}
+	return obj.(*powergridv10.Command), nil
+}
+
 func GetCommand(name string) (*powergridv10.Command, error) {
-	commands, err := commandInformer.GetIndexer().ByIndex(ByName, name)
+	commands, err := commandInformer.ByIndex(ByName, name)
 	if err != nil {
 		return nil, err
 	}
+	commands = filterWatched(commands)
 	if len(commands) == 0 {
 		return nil, fmt.Errorf("no command matches the name %s", name)
 	}
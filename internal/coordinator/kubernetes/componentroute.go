@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"fmt"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	informers "github.com/sportshead/powergrid/pkg/generated/informers/externalversions"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"k8s.io/client-go/tools/cache"
+	"log/slog"
+	"os"
+)
+
+const ByPrefix = "CustomIDPrefixIndexer"
+
+var componentRouteInformer *namespacedInformer
+
+func loadComponentRoutes(factories []informers.SharedInformerFactory) {
+	componentRouteInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Powergrid().V10().ComponentRoutes().Informer()
+	})
+	componentRouteHealth.track(componentRouteInformer.informers...)
+	err := componentRouteInformer.AddIndexers(map[string]cache.IndexFunc{
+		ByPrefix: func(obj interface{}) ([]string, error) {
+			route := obj.(*powergridv10.ComponentRoute)
+			return []string{route.Spec.Prefix}, nil
+		},
+	})
+	if err != nil {
+		slog.Error("failed to add indexer", utils.Tag("k8s_indexer_failed"), utils.Error(err))
+		os.Exit(1)
+	}
+}
+
+// GetComponentRoute returns the ComponentRoute for the custom_id prefix, or nil if there is none.
+func GetComponentRoute(prefix string) (*powergridv10.ComponentRoute, error) {
+	routes, err := componentRouteInformer.ByIndex(ByPrefix, prefix)
+	if err != nil {
+		return nil, err
+	}
+	routes = filterWatched(routes)
+	if len(routes) == 0 {
+		return nil, nil
+	}
+	if len(routes) > 1 {
+		return nil, fmt.Errorf("%d component routes match the prefix %s", len(routes), prefix)
+	}
+
+	return routes[0].(*powergridv10.ComponentRoute), nil
+}
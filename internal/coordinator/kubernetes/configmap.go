@@ -0,0 +1,27 @@
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+var configMapInformer *namespacedInformer
+
+func loadConfigMaps(factories []informers.SharedInformerFactory) {
+	configMapInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Core().V1().ConfigMaps().Informer()
+	})
+	configMapHealth.track(configMapInformer.informers...)
+}
+
+// GetConfigMapValue returns the value of the key in the ConfigMap, and whether it exists.
+func GetConfigMapValue(namespace, name, key string) (string, bool, error) {
+	obj, exists, err := configMapInformer.GetByKey(namespace + "/" + name)
+	if err != nil || !exists {
+		return "", false, err
+	}
+
+	value, ok := obj.(*corev1.ConfigMap).Data[key]
+	return value, ok, nil
+}
@@ -0,0 +1,89 @@
+package kubernetes
+
+import (
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	powergridscheme "github.com/sportshead/powergrid/pkg/generated/clientset/versioned/scheme"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	"strings"
+)
+
+// Reasons of the events recorded by the coordinator. Reasons for syncing commands are in the discord package.
+const (
+	ReasonServiceMissing        = "ServiceMissing"
+	ReasonServicePortMissing    = "ServicePortMissing"
+	ReasonReferenceNotAllowed   = "ReferenceNotAllowed"
+	ReasonUnknownCommand        = "UnknownCommand"
+	ReasonCABundleMissing       = "CABundleMissing"
+	ReasonStaticResponseInvalid = "StaticResponseInvalid"
+)
+
+const (
+	eventComponent = "powergrid-coordinator"
+	// eventBurstSize and eventRefillQPS limit the events for each object and reason to a burst of 5, then one a minute.
+	eventBurstSize         = 5
+	eventRefillQPS float32 = 1. / 60
+)
+
+// Recorder records events on powergrid and core objects, so that failures can be seen with kubectl describe.
+// Events are rate limited for each object and reason.
+var Recorder record.EventRecorder
+
+var eventBroadcaster record.EventBroadcaster
+
+// eventSpamKey rate limits events separately for each reason, so that repeated failures of one kind don't hide others.
+func eventSpamKey(event *corev1.Event) string {
+	return strings.Join([]string{
+		event.Source.Component,
+		event.Source.Host,
+		event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace,
+		event.InvolvedObject.Name,
+		string(event.InvolvedObject.UID),
+		event.InvolvedObject.APIVersion,
+		event.Reason,
+	}, "")
+}
+
+func initEvents() {
+	eventScheme := runtime.NewScheme()
+	utilruntime.Must(scheme.AddToScheme(eventScheme))
+	utilruntime.Must(powergridscheme.AddToScheme(eventScheme))
+
+	eventBroadcaster = record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{
+		BurstSize:   eventBurstSize,
+		QPS:         eventRefillQPS,
+		SpamKeyFunc: eventSpamKey,
+	})
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubernetesClient.CoreV1().Events("")})
+	Recorder = eventBroadcaster.NewRecorder(eventScheme, corev1.EventSource{Component: eventComponent, Host: env.Hostname})
+
+	cleanupGroup.Add(1)
+	go func() {
+		defer cleanupGroup.Done()
+		<-stop
+		eventBroadcaster.Shutdown()
+	}()
+}
+
+// PodReference refers to the coordinator's pod, for events which have no related object, such as unknown commands.
+func PodReference() *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  namespace,
+		Name:       env.Hostname,
+	}
+}
+
+// recordWarning records a warning event on the object, unless it is nil.
+func recordWarning(object runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	if object == nil {
+		return
+	}
+	Recorder.Eventf(object, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
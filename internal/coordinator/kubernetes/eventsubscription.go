@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	informers "github.com/sportshead/powergrid/pkg/generated/informers/externalversions"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"k8s.io/client-go/tools/cache"
+	"log/slog"
+	"os"
+)
+
+const ByEventType = "DiscordEventTypeIndexer"
+
+// AllEventTypes is the index value for subscriptions without any event types, which receive every event.
+const AllEventTypes = "*"
+
+var eventSubscriptionInformer *namespacedInformer
+
+func loadEventSubscriptions(factories []informers.SharedInformerFactory) {
+	eventSubscriptionInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Powergrid().V10().EventSubscriptions().Informer()
+	})
+	eventSubscriptionHealth.track(eventSubscriptionInformer.informers...)
+	err := eventSubscriptionInformer.AddIndexers(map[string]cache.IndexFunc{
+		ByEventType: func(obj interface{}) ([]string, error) {
+			subscription := obj.(*powergridv10.EventSubscription)
+			if len(subscription.Spec.EventTypes) == 0 {
+				return []string{AllEventTypes}, nil
+			}
+			return subscription.Spec.EventTypes, nil
+		},
+	})
+	if err != nil {
+		slog.Error("failed to add indexer", utils.Tag("k8s_indexer_failed"), utils.Error(err))
+		os.Exit(1)
+	}
+}
+
+// GetEventSubscriptions returns the subscriptions which should receive events of the given type.
+func GetEventSubscriptions(eventType string) ([]*powergridv10.EventSubscription, error) {
+	var subscriptions []*powergridv10.EventSubscription
+	for _, key := range []string{eventType, AllEventTypes} {
+		objs, err := eventSubscriptionInformer.ByIndex(ByEventType, key)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range filterWatched(objs) {
+			subscriptions = append(subscriptions, obj.(*powergridv10.EventSubscription))
+		}
+	}
+	return subscriptions, nil
+}
@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"fmt"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"k8s.io/client-go/tools/cache"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// syncTimeout is how long an informer can take to sync after starting before it is considered stuck.
+	syncTimeout = 2 * time.Minute
+	// watchFailureTimeout is how long an informer's watch can keep failing before it is considered stuck.
+	watchFailureTimeout = 5 * time.Minute
+	// watchRecoveryWindow is how long after the last watch error an informer's watch is considered to have recovered.
+	// The reflector retries failed watches with a backoff of at most 30s.
+	watchRecoveryWindow = time.Minute
+)
+
+// informerHealth tracks the sync and watch state of an informer, for the readiness and liveness probes.
+type informerHealth struct {
+	name    string
+	started time.Time
+
+	mutex        sync.Mutex
+	informers    []cache.SharedIndexInformer
+	failingSince time.Time
+	lastFailure  time.Time
+	lastError    error
+}
+
+var commandHealth = &informerHealth{name: "command", started: time.Now()}
+var serviceHealth = &informerHealth{name: "service", started: time.Now()}
+var accessPolicyHealth = &informerHealth{name: "accesspolicy", started: time.Now()}
+var componentRouteHealth = &informerHealth{name: "componentroute", started: time.Now()}
+var referenceGrantHealth = &informerHealth{name: "referencegrant", started: time.Now()}
+var eventSubscriptionHealth = &informerHealth{name: "eventsubscription", started: time.Now()}
+var configMapHealth = &informerHealth{name: "configmap", started: time.Now()}
+var endpointSliceHealth = &informerHealth{name: "endpointslice", started: time.Now()}
+var namespaceHealth = &informerHealth{name: "namespace", started: time.Now()}
+
+// healthChecks are the informers routing reads from. namespaceHealth is added if namespaces are selected by label.
+var healthChecks = []*informerHealth{
+	commandHealth,
+	serviceHealth,
+	accessPolicyHealth,
+	componentRouteHealth,
+	referenceGrantHealth,
+	eventSubscriptionHealth,
+	configMapHealth,
+	endpointSliceHealth,
+}
+
+// track sets the informers, e.g. of each watched namespace, to be checked by the readiness and liveness probes.
+// Must be called before the informers are started.
+func (h *informerHealth) track(informers ...cache.SharedIndexInformer) {
+	for _, informer := range informers {
+		err := informer.SetWatchErrorHandler(h.watchFailed)
+		if err != nil {
+			slog.Error("failed to set watch error handler", utils.Tag("k8s_watch_handler_failed"), utils.Error(err), slog.String("informer", h.name))
+		}
+	}
+
+	h.mutex.Lock()
+	h.informers = informers
+	h.mutex.Unlock()
+}
+
+func (h *informerHealth) synced() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.syncedLocked()
+}
+
+func (h *informerHealth) syncedLocked() bool {
+	if len(h.informers) == 0 {
+		return false
+	}
+	for _, informer := range h.informers {
+		if !informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *informerHealth) watchFailed(r *cache.Reflector, err error) {
+	cache.DefaultWatchErrorHandler(r, err)
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	now := time.Now()
+	if now.Sub(h.lastFailure) > watchRecoveryWindow {
+		h.failingSince = now
+	}
+	h.lastFailure = now
+	h.lastError = err
+}
+
+// stuck returns an error if the informer has not synced in time, or its watch has been failing for too long.
+func (h *informerHealth) stuck() error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, informer := range h.informers {
+		if informer.IsStopped() {
+			return fmt.Errorf("%s informer stopped", h.name)
+		}
+	}
+	if !h.syncedLocked() && time.Since(h.started) > syncTimeout {
+		return fmt.Errorf("%s informer not synced after %s", h.name, syncTimeout)
+	}
+	if time.Since(h.lastFailure) < watchRecoveryWindow && h.lastFailure.Sub(h.failingSince) > watchFailureTimeout {
+		return fmt.Errorf("%s informer watch failing since %s: %w", h.name, h.failingSince.Format(time.RFC3339), h.lastError)
+	}
+	return nil
+}
+
+// Synced returns an error naming the first tracked informer which has not synced yet, if any.
+// Commands must not be looked up before this returns nil, as the stores may be empty.
+func Synced() error {
+	for _, h := range healthChecks {
+		if !h.synced() {
+			return fmt.Errorf("%s informer not synced", h.name)
+		}
+	}
+	return nil
+}
+
+// Live returns an error if any tracked informer is stuck, in which case the coordinator should be restarted.
+func Live() error {
+	for _, h := range healthChecks {
+		if err := h.stuck(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
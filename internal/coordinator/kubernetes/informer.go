@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"slices"
+)
+
+// namespacedInformer combines an informer for each namespace returned by informerNamespaces, so only the watched namespaces need a Role.
+type namespacedInformer struct {
+	namespaces []string
+	informers  []cache.SharedIndexInformer
+}
+
+// newNamespacedInformer creates an informer from each factory, which must be created for each namespace returned by informerNamespaces in order.
+func newNamespacedInformer[F any](factories []F, informer func(factory F) cache.SharedIndexInformer) *namespacedInformer {
+	n := &namespacedInformer{namespaces: informerNamespaces()}
+	for _, factory := range factories {
+		n.informers = append(n.informers, informer(factory))
+	}
+	return n
+}
+
+// AddIndexers adds the indexers to the informer of every namespace.
+func (n *namespacedInformer) AddIndexers(indexers cache.Indexers) error {
+	for _, informer := range n.informers {
+		err := informer.AddIndexers(indexers)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddEventHandler adds the handler to the informer of every namespace.
+func (n *namespacedInformer) AddEventHandler(handler cache.ResourceEventHandler) error {
+	for _, informer := range n.informers {
+		_, err := informer.AddEventHandler(handler)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetByKey returns the object with the namespace/name key from the informer of its namespace.
+func (n *namespacedInformer) GetByKey(key string) (interface{}, bool, error) {
+	ns, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, false, err
+	}
+	i := slices.Index(n.namespaces, ns)
+	if i == -1 {
+		i = slices.Index(n.namespaces, metav1.NamespaceAll)
+	}
+	if i == -1 {
+		return nil, false, nil
+	}
+	return n.informers[i].GetIndexer().GetByKey(key)
+}
+
+// ByIndex returns the objects of every namespace matching the indexed value.
+func (n *namespacedInformer) ByIndex(indexName, indexedValue string) ([]interface{}, error) {
+	var objs []interface{}
+	for _, informer := range n.informers {
+		matches, err := informer.GetIndexer().ByIndex(indexName, indexedValue)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, matches...)
+	}
+	return objs, nil
+}
+
+// ListIndexFuncValues returns the indexed values of every namespace, without duplicates.
+func (n *namespacedInformer) ListIndexFuncValues(indexName string) []string {
+	var values []string
+	seen := make(map[string]bool)
+	for _, informer := range n.informers {
+		for _, value := range informer.GetIndexer().ListIndexFuncValues(indexName) {
+			if !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// List returns the objects of every namespace.
+func (n *namespacedInformer) List() []interface{} {
+	var objs []interface{}
+	for _, informer := range n.informers {
+		objs = append(objs, informer.GetStore().List()...)
+	}
+	return objs
+}
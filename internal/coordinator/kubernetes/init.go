@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"github.com/go-logr/logr"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
 	clientset "github.com/sportshead/powergrid/pkg/generated/clientset/versioned"
 	"github.com/sportshead/powergrid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -20,15 +21,17 @@ var powergridClient *clientset.Clientset
 var kubernetesClient *kubernetes.Clientset
 var namespace = corev1.NamespaceDefault
 
-var stop chan struct{}
+var stopper *utils.Stopper
+var stop <-chan struct{}
 var cleanupGroup *sync.WaitGroup
 
 func init() {
 	klog.SetLogger(logr.Discard())
 }
 
-func Init(ch chan struct{}, w *sync.WaitGroup) {
-	stop = ch
+func Init(s *utils.Stopper, w *sync.WaitGroup) {
+	stopper = s
+	stop = s.Done()
 	cleanupGroup = w
 
 	var err error
@@ -56,9 +59,15 @@ func Init(ch chan struct{}, w *sync.WaitGroup) {
 		namespace = strings.TrimSpace(string(data))
 	}
 
+	initNamespaces()
+	initEvents()
+	loadNamespaces()
+
 	slog.Info("initiated kubernetes client",
 		utils.Tag("k8s_client_created"),
 		slog.String("namespace", namespace),
+		slog.Any("watch_namespaces", env.WatchNamespaces),
+		slog.String("watch_namespace_selector", env.WatchNamespaceSelector),
 		slog.String("host", config.Host))
 
 	go loadCommands()
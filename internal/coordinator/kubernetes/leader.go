@@ -9,9 +9,27 @@ import (
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"log/slog"
+	"sync/atomic"
 	"time"
 )
 
+// leading is set while the coordinator is the leader, and reported by /readyz.
+var leading atomic.Bool
+
+// IsLeader indicates whether the coordinator is currently the leader.
+func IsLeader() bool {
+	return leading.Load()
+}
+
+// leader is the identity of the current leader, as last observed.
+var leader atomic.Value
+
+// Leader returns the identity of the current leader, or an empty string if none has been observed yet.
+func Leader() string {
+	id, _ := leader.Load().(string)
+	return id
+}
+
 func startLeader() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -45,18 +63,22 @@ func startLeader() {
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				slog.Info("started leading", utils.Tag("lead_start"), slog.String("id", env.Hostname))
+				leading.Store(true)
+				go wait.NonSlidingUntilWithContext(ctx, scaleDownIdle, time.Minute)
 				wait.NonSlidingUntilWithContext(ctx, updateCommands, time.Minute)
 			},
 			OnStoppedLeading: func() {
+				leading.Store(false)
 				slog.Error("stopped leading", utils.Tag("lead_lost"), slog.String("id", env.Hostname))
-				close(stop)
+				stopper.Stop()
 			},
-			OnNewLeader: func(leader string) {
-				if leader == env.Hostname {
+			OnNewLeader: func(identity string) {
+				leader.Store(identity)
+				if identity == env.Hostname {
 					// we're leading
 					return
 				}
-				slog.Info("observed new leader", utils.Tag("lead_changed"), slog.String("leader", leader))
+				slog.Info("observed new leader", utils.Tag("lead_changed"), slog.String("leader", identity))
 			},
 		},
 	})
@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	"github.com/sportshead/powergrid/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+)
+
+var namespaceSelector labels.Selector
+var namespaceInformer cache.SharedIndexInformer
+
+func initNamespaces() {
+	if env.WatchNamespaceSelector == "" {
+		return
+	}
+
+	var err error
+	namespaceSelector, err = labels.Parse(env.WatchNamespaceSelector)
+	if err != nil {
+		slog.Error("failed to parse namespace selector", utils.Tag("invalid_env"), utils.Error(err), slog.String("key", "WATCH_NAMESPACE_SELECTOR"))
+		os.Exit(1)
+	}
+}
+
+// watchesOtherNamespaces indicates whether resources outside the coordinator's namespace are watched.
+// Results are filtered with IsWatchedNamespace if so.
+func watchesOtherNamespaces() bool {
+	return len(env.WatchNamespaces) > 0 || namespaceSelector != nil
+}
+
+// informerNamespaces returns the namespaces informers are created for: the coordinator's namespace and WATCH_NAMESPACES,
+// or a single cluster-wide informer if namespaces are selected by label, which needs a ClusterRole.
+func informerNamespaces() []string {
+	if namespaceSelector != nil {
+		return []string{metav1.NamespaceAll}
+	}
+	namespaces := []string{namespace}
+	for _, ns := range env.WatchNamespaces {
+		if !slices.Contains(namespaces, ns) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// loadNamespaces starts the namespace informer used by IsWatchedNamespace, if namespaces are selected by label.
+// It is started before the other informers, as Commands are filtered with it as soon as they are synced.
+func loadNamespaces() {
+	if namespaceSelector == nil {
+		return
+	}
+	factory := informers.NewSharedInformerFactory(kubernetesClient, 10*time.Minute)
+	namespaceInformer = factory.Core().V1().Namespaces().Informer()
+	namespaceHealth.track(namespaceInformer)
+	healthChecks = append(healthChecks, namespaceHealth)
+	factory.Start(stop)
+}
+
+// namespacesSynced waits for the namespace informer to sync, if it is used.
+func namespacesSynced() bool {
+	if namespaceInformer == nil {
+		return true
+	}
+	return cache.WaitForCacheSync(stop, namespaceInformer.HasSynced)
+}
+
+// IsWatchedNamespace checks whether powergrid resources in the namespace should be used.
+func IsWatchedNamespace(ns string) bool {
+	if ns == namespace || slices.Contains(env.WatchNamespaces, ns) {
+		return true
+	}
+	if namespaceSelector == nil {
+		return false
+	}
+
+	obj, exists, err := namespaceInformer.GetIndexer().GetByKey(ns)
+	if err != nil || !exists {
+		return false
+	}
+	return namespaceSelector.Matches(labels.Set(obj.(*corev1.Namespace).Labels))
+}
+
+// filterWatched removes objects outside the watched namespaces.
+func filterWatched(objs []interface{}) []interface{} {
+	if !watchesOtherNamespaces() {
+		return objs
+	}
+	return slices.DeleteFunc(objs, func(obj interface{}) bool {
+		accessor, err := meta.Accessor(obj)
+		return err != nil || !IsWatchedNamespace(accessor.GetNamespace())
+	})
+}
+
+// ParseServiceRef splits a reference to a service, which is either a name or namespace/name.
+// defaultNamespace is used if the reference has no namespace, or the coordinator's namespace if empty.
+func ParseServiceRef(defaultNamespace, ref string) (string, string) {
+	if defaultNamespace == "" {
+		defaultNamespace = namespace
+	}
+	return routing.ParseServiceRef(defaultNamespace, ref)
+}
@@ -0,0 +1,35 @@
+package kubernetes
+
+import (
+	"github.com/sportshead/powergrid/internal/coordinator/routing"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	informers "github.com/sportshead/powergrid/pkg/generated/informers/externalversions"
+	"k8s.io/client-go/tools/cache"
+	"slices"
+)
+
+var referenceGrantInformer *namespacedInformer
+
+func loadReferenceGrants(factories []informers.SharedInformerFactory) {
+	// informers from the factory are already indexed by namespace
+	referenceGrantInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Powergrid().V10().ReferenceGrants().Informer()
+	})
+	referenceGrantHealth.track(referenceGrantInformer.informers...)
+}
+
+// isReferenceAllowed checks whether resources in the from namespace may reference the service in the to namespace.
+// References within the same namespace are always allowed.
+func isReferenceAllowed(from, to, serviceName string) bool {
+	if from == to {
+		return true
+	}
+
+	grants, err := referenceGrantInformer.ByIndex(cache.NamespaceIndex, to)
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(grants, func(obj interface{}) bool {
+		return routing.GrantAllows(obj.(*powergridv10.ReferenceGrant), from, serviceName)
+	})
+}
@@ -0,0 +1,28 @@
+package kubernetes
+
+import (
+	"context"
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	informers "github.com/sportshead/powergrid/pkg/generated/informers/externalversions"
+	"k8s.io/client-go/tools/cache"
+)
+
+var roleConnectionMetadataInformer *namespacedInformer
+
+// updateRoleConnectionMetadata syncs the application's role connection metadata with the RoleConnectionMetadataRecords.
+// It is left alone if linked roles are disabled and there are no records, so metadata managed elsewhere isn't removed.
+func updateRoleConnectionMetadata(ctx context.Context) {
+	list := filterWatched(roleConnectionMetadataInformer.List())
+	if env.DiscordOAuthRedirectURI == "" && len(list) == 0 {
+		return
+	}
+
+	discord.UpdateRoleConnectionMetadata(ctx, list)
+}
+
+func loadRoleConnectionMetadata(factories []informers.SharedInformerFactory) {
+	roleConnectionMetadataInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Powergrid().V10().RoleConnectionMetadataRecords().Informer()
+	})
+}
@@ -0,0 +1,73 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sync"
+	"time"
+)
+
+// secretCacheTTL is how long Secrets read by GetSecretValue are cached for, to avoid a request to the API server for every interaction.
+const secretCacheTTL = time.Minute
+
+type cachedSecret struct {
+	data    map[string][]byte
+	expires time.Time
+}
+
+var secretCache = make(map[string]cachedSecret)
+var secretCacheMutex sync.Mutex
+
+// GetSecretValue returns the value of the key in the Secret, and whether it exists.
+// Secrets are read directly instead of through an informer, so the coordinator does not need to watch every Secret.
+func GetSecretValue(ctx context.Context, namespace, name, key string) (string, bool, error) {
+	cacheKey := namespace + "/" + name
+
+	secretCacheMutex.Lock()
+	cached, ok := secretCache[cacheKey]
+	secretCacheMutex.Unlock()
+
+	if !ok || time.Now().After(cached.expires) {
+		secret, err := kubernetesClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", false, err
+		}
+
+		cached = cachedSecret{
+			data:    secret.Data,
+			expires: time.Now().Add(secretCacheTTL),
+		}
+		secretCacheMutex.Lock()
+		secretCache[cacheKey] = cached
+		secretCacheMutex.Unlock()
+	}
+
+	value, ok := cached.data[key]
+	return string(value), ok, nil
+}
+
+// GetSecretKeyRef returns the value of the key in the Secret, returning an error if it does not exist.
+func GetSecretKeyRef(ctx context.Context, namespace string, ref *powergridv10.SecretKeyRef) (string, error) {
+	value, exists, err := GetSecretValue(ctx, namespace, ref.Name, ref.Key)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("secret %s has no key %s", ref.Name, ref.Key)
+	}
+	return value, nil
+}
+
+// GetHeaderValue returns the value of the header, which is either set directly or read from a Secret in the namespace.
+func GetHeaderValue(ctx context.Context, namespace string, header powergridv10.ExternalHeader) (string, error) {
+	if header.ValueFrom == nil {
+		return header.Value, nil
+	}
+	value, err := GetSecretKeyRef(ctx, namespace, header.ValueFrom)
+	if err != nil {
+		return "", fmt.Errorf("failed to get value of header %s: %w", header.Name, err)
+	}
+	return value, nil
+}
@@ -1,65 +1,245 @@
 package kubernetes
 
 import (
+	"fmt"
 	"github.com/sportshead/powergrid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"log/slog"
 	"net"
+	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
-var serviceInformer cache.SharedIndexInformer
+var serviceInformer *namespacedInformer
+
+// addrsRemovedHandlers are called with the addresses no longer used by a Service, see OnServiceAddrsRemoved.
+var addrsRemovedHandlers []func(addrs []string)
+var addrsRemovedHandlersMutex sync.Mutex
+
+// SchemeGRPC is returned by GetServiceAddr for ports with an appProtocol of grpc.
+const SchemeGRPC = "grpc"
+
+const (
+	// AnnotationCABundle is set on a Service with an https port to the name of a ConfigMap in its namespace, containing the PEM CA bundle
+	// the service's certificate is verified with. The system roots are used if unset.
+	AnnotationCABundle = "powergrid.sportshead.dev/ca-bundle"
+	// AnnotationCABundleKey is set on a Service to the key of the CA bundle in its AnnotationCABundle ConfigMap, defaulting to DefaultCABundleKey.
+	AnnotationCABundleKey = "powergrid.sportshead.dev/ca-bundle-key"
+)
+
+// DefaultCABundleKey is the key of the CA bundle in a ConfigMap, as used by kube-root-ca.crt and trust-manager.
+const DefaultCABundleKey = "ca.crt"
 
 func loadServices() {
-	factory := informers.NewSharedInformerFactoryWithOptions(kubernetesClient, 10*time.Minute, informers.WithNamespace(namespace))
-	serviceInformer = factory.Core().V1().Services().Informer()
+	var factories []informers.SharedInformerFactory
+	for _, ns := range informerNamespaces() {
+		factories = append(factories, informers.NewSharedInformerFactoryWithOptions(kubernetesClient, 10*time.Minute, informers.WithNamespace(ns)))
+	}
+	serviceInformer = newNamespacedInformer(factories, func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return factory.Core().V1().Services().Informer()
+	})
+	err := serviceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			addrs := serviceAddrs(newObj.(*corev1.Service))
+			removed := slices.DeleteFunc(serviceAddrs(oldObj.(*corev1.Service)), func(addr string) bool {
+				return slices.Contains(addrs, addr)
+			})
+			serviceAddrsRemoved(removed)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if service, ok := obj.(*corev1.Service); ok {
+				serviceAddrsRemoved(serviceAddrs(service))
+			}
+		},
+	})
+	if err != nil {
+		slog.Error("failed to add event handler", utils.Tag("k8s_event_handler_failed"), utils.Error(err))
+		os.Exit(1)
+	}
+	serviceHealth.track(serviceInformer.informers...)
+	loadConfigMaps(factories)
+	loadEndpointSlices(factories)
 
 	stopCh := make(chan struct{})
-	factory.Start(stopCh)            // start goroutines
-	factory.WaitForCacheSync(stopCh) // wait for init
+	for _, factory := range factories {
+		factory.Start(stopCh) // start goroutines
+	}
+	for _, factory := range factories {
+		factory.WaitForCacheSync(stopCh) // wait for init
+	}
+}
+
+// OnServiceAddrsRemoved registers f to be called with the addresses returned by GetServiceAddr which a Service no longer uses,
+// once its clusterIP or ports change or it is deleted, so connections to them can be closed.
+func OnServiceAddrsRemoved(f func(addrs []string)) {
+	addrsRemovedHandlersMutex.Lock()
+	defer addrsRemovedHandlersMutex.Unlock()
+	addrsRemovedHandlers = append(addrsRemovedHandlers, f)
+}
+
+func serviceAddrsRemoved(addrs []string) {
+	if len(addrs) == 0 {
+		return
+	}
+	addrsRemovedHandlersMutex.Lock()
+	handlers := slices.Clone(addrsRemovedHandlers)
+	addrsRemovedHandlersMutex.Unlock()
+	for _, f := range handlers {
+		f(addrs)
+	}
+}
+
+// serviceAddrs lists the clusterIP addresses of the service's ports, as returned by GetServiceAddr for http and grpc ports.
+func serviceAddrs(service *corev1.Service) []string {
+	ip := service.Spec.ClusterIP
+	if ip == "" || ip == "None" {
+		return nil
+	}
+	addrs := make([]string, len(service.Spec.Ports))
+	for i, port := range service.Spec.Ports {
+		addrs[i] = net.JoinHostPort(ip, strconv.Itoa(int(port.Port)))
+	}
+	return addrs
+}
+
+// GetServiceAddr resolves a reference to a service, which is either a name or namespace/name, to the address of one of its ports.
+// from is the namespace of the resource containing the reference, or empty for the coordinator's namespace.
+// port selects the port by name or number, otherwise the port named "http" or the first port is used.
+// The scheme of the port is returned with the address, which is "https" or SchemeGRPC if the port's appProtocol is https or grpc.
+// Failures are recorded as events on referrer, the object containing the reference, unless it is nil, and on the Service.
+func GetServiceAddr(log *slog.Logger, referrer runtime.Object, from string, ref string, port *intstr.IntOrString) (string, string) {
+	return getServiceAddr(log, referrer, true, from, ref, port)
+}
+
+// LookupServiceAddr resolves a reference to a service like GetServiceAddr, without recording events, for read-only callers such as the admin API.
+func LookupServiceAddr(log *slog.Logger, from string, ref string, port *intstr.IntOrString) (string, string) {
+	return getServiceAddr(log, nil, false, from, ref, port)
 }
 
-func GetServiceAddr(log *slog.Logger, serviceName string) string {
-	log = log.With(slog.String("name", serviceName))
-	svc, exists, err := serviceInformer.GetIndexer().GetByKey(namespace + "/" + serviceName)
+func getServiceAddr(log *slog.Logger, referrer runtime.Object, record bool, from string, ref string, port *intstr.IntOrString) (string, string) {
+	if from == "" {
+		from = namespace
+	}
+	serviceNamespace, serviceName := ParseServiceRef(from, ref)
+	log = log.With(slog.String("name", serviceName), slog.String("namespace", serviceNamespace))
+
+	if !isReferenceAllowed(from, serviceNamespace, serviceName) {
+		log.Error("reference to service is not allowed by a reference grant", utils.Tag("k8s_service_reference_denied"), slog.String("from", from))
+		recordWarning(referrer, ReasonReferenceNotAllowed, "Reference to service %s/%s is not allowed by a ReferenceGrant", serviceNamespace, serviceName)
+		return "", ""
+	}
+	if namespaceSelector == nil && !IsWatchedNamespace(serviceNamespace) {
+		log.Error("service namespace is not watched", utils.Tag("k8s_service_namespace_unwatched"))
+		return "", ""
+	}
+
+	svc, exists, err := serviceInformer.GetByKey(serviceNamespace + "/" + serviceName)
 	if err != nil {
 		log.Error("failed to get service", utils.Tag("k8s_service_get_failed"), utils.Error(err))
-		return ""
+		return "", ""
 	}
 	if !exists {
 		log.Error("service does not exist", utils.Tag("k8s_service_missing"))
-		return ""
+		recordWarning(referrer, ReasonServiceMissing, "Service %s/%s does not exist", serviceNamespace, serviceName)
+		return "", ""
 	}
 
 	log = log.With(slog.String("object", utils.TryMarshal(svc)))
 	service, ok := svc.(*corev1.Service)
 	if !ok {
 		log.Error("failed to cast service", utils.Tag("k8s_service_cast_failed"))
-		return ""
+		return "", ""
 	}
 
 	ip := service.Spec.ClusterIP
 	if ip == "" || ip == "None" {
 		log.Error("service has no clusterIP", utils.Tag("k8s_service_missing_cluster_ip"))
-		return ""
+		return "", ""
 	}
 
-	ports := service.Spec.Ports
-	port := ""
-	// get http port, otherwise first port
-	for _, p := range ports {
-		if port == "" || p.Name == "http" {
-			port = strconv.Itoa(int(p.Port))
-			break
+	servicePort := findPort(service.Spec.Ports, port)
+	if servicePort == nil {
+		log.Error("service has no matching port", utils.Tag("k8s_service_missing_http_port"), slog.String("port", port.String()))
+		recordWarning(referrer, ReasonServicePortMissing, "Service %s/%s has no port matching %q", serviceNamespace, serviceName, port.String())
+		if record {
+			recordWarning(service, ReasonServicePortMissing, "Service has no port matching %q", port.String())
 		}
+		return "", ""
+	}
+
+	if servicePort.AppProtocol != nil && strings.EqualFold(*servicePort.AppProtocol, SchemeGRPC) {
+		return net.JoinHostPort(ip, strconv.Itoa(int(servicePort.Port))), SchemeGRPC
+	}
+	if servicePort.AppProtocol != nil && strings.EqualFold(*servicePort.AppProtocol, "https") {
+		// certificates are issued for the service's DNS name, not its clusterIP
+		return net.JoinHostPort(service.Name+"."+service.Namespace+".svc", strconv.Itoa(int(servicePort.Port))), "https"
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(int(servicePort.Port))), "http"
+}
+
+// GetServiceCABundle returns the CA bundle of the service with the host returned by GetServiceAddr for https ports, i.e. name.namespace.svc,
+// or nil if the host isn't a watched service or the service has no AnnotationCABundle.
+func GetServiceCABundle(host string) ([]byte, error) {
+	name, serviceNamespace, ok := strings.Cut(strings.TrimSuffix(host, ".svc"), ".")
+	if !ok || !strings.HasSuffix(host, ".svc") || strings.Contains(serviceNamespace, ".") {
+		return nil, nil
+	}
+
+	obj, exists, err := serviceInformer.GetByKey(serviceNamespace + "/" + name)
+	if err != nil || !exists {
+		return nil, err
 	}
-	if port == "" {
-		log.Error("service has no http port", utils.Tag("k8s_service_missing_http_port"))
-		return ""
+	service := obj.(*corev1.Service)
+	configMap, ok := service.Annotations[AnnotationCABundle]
+	if !ok || configMap == "" {
+		return nil, nil
 	}
+	key := DefaultCABundleKey
+	if value, ok := service.Annotations[AnnotationCABundleKey]; ok && value != "" {
+		key = value
+	}
+
+	bundle, exists, err := GetConfigMapValue(serviceNamespace, configMap, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		recordWarning(service, ReasonCABundleMissing, "ConfigMap %s has no key %s", configMap, key)
+		return nil, fmt.Errorf("ca bundle configmap %s/%s has no key %s", serviceNamespace, configMap, key)
+	}
+	return []byte(bundle), nil
+}
 
-	return net.JoinHostPort(ip, port)
+// findPort selects a port by name or number, otherwise the port named "http" or the first port.
+func findPort(ports []corev1.ServicePort, port *intstr.IntOrString) *corev1.ServicePort {
+	if len(ports) == 0 {
+		return nil
+	}
+
+	for i, p := range ports {
+		switch {
+		case port == nil && p.Name == "http":
+			return &ports[i]
+		case port != nil && port.Type == intstr.Int && p.Port == port.IntVal:
+			return &ports[i]
+		case port != nil && port.Type == intstr.String && p.Name == port.StrVal:
+			return &ports[i]
+		}
+	}
+
+	if port == nil {
+		return &ports[0]
+	}
+	return nil
 }
@@ -0,0 +1,60 @@
+package kubernetes
+
+import (
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"slices"
+)
+
+// IndexedCommands returns the watched Command objects by the name of their Discord command, as used by GetCommand.
+// Command objects which failed to parse are not indexed, and are returned separately.
+func IndexedCommands() (map[string][]*powergridv10.Command, []*powergridv10.Command) {
+	indexed := make(map[string][]*powergridv10.Command)
+	seen := make(map[*powergridv10.Command]bool)
+	for _, name := range commandInformer.ListIndexFuncValues(ByName) {
+		objs, err := commandInformer.ByIndex(ByName, name)
+		if err != nil {
+			continue
+		}
+		for _, obj := range filterWatched(objs) {
+			command := obj.(*powergridv10.Command)
+			indexed[name] = append(indexed[name], command)
+			seen[command] = true
+		}
+	}
+
+	var unindexed []*powergridv10.Command
+	for _, obj := range filterWatched(commandInformer.List()) {
+		command := obj.(*powergridv10.Command)
+		if !seen[command] {
+			unindexed = append(unindexed, command)
+		}
+	}
+	return indexed, unindexed
+}
+
+// ListComponentRoutes returns the watched ComponentRoute objects, sorted by prefix.
+func ListComponentRoutes() []*powergridv10.ComponentRoute {
+	var routes []*powergridv10.ComponentRoute
+	for _, obj := range filterWatched(componentRouteInformer.List()) {
+		routes = append(routes, obj.(*powergridv10.ComponentRoute))
+	}
+	slices.SortFunc(routes, func(a, b *powergridv10.ComponentRoute) int {
+		if a.Spec.Prefix < b.Spec.Prefix {
+			return -1
+		}
+		if a.Spec.Prefix > b.Spec.Prefix {
+			return 1
+		}
+		return 0
+	})
+	return routes
+}
+
+// ServiceReady checks whether a reference to a service, which is either a name or namespace/name, has any ready endpoints.
+func ServiceReady(from string, ref string) bool {
+	if from == "" {
+		from = namespace
+	}
+	serviceNamespace, serviceName := ParseServiceRef(from, ref)
+	return serviceReady(serviceNamespace, serviceName)
+}
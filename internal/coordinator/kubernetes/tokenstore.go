@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/internal/coordinator/linkedroles"
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"time"
+)
+
+const (
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+	LabelUserID    = "powergrid.sportshead.dev/user-id"
+)
+
+// SecretTokenStore is a linkedroles.TokenStore which keeps each user's token in a Secret in the coordinator's namespace.
+type SecretTokenStore struct{}
+
+var _ linkedroles.TokenStore = SecretTokenStore{}
+
+func secretName(userID string) string {
+	return env.DeploymentName + "-linked-roles-" + userID
+}
+
+func (SecretTokenStore) Get(ctx context.Context, userID string) (*oauth2.Token, error) {
+	secret, err := kubernetesClient.CoreV1().Secrets(namespace).Get(ctx, secretName(userID), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, linkedroles.ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  string(secret.Data["access_token"]),
+		TokenType:    string(secret.Data["token_type"]),
+		RefreshToken: string(secret.Data["refresh_token"]),
+	}
+	if expiry := secret.Data["expiry"]; len(expiry) > 0 {
+		token.Expiry, err = time.Parse(time.RFC3339, string(expiry))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return token, nil
+}
+
+func (SecretTokenStore) Set(ctx context.Context, userID string, token *oauth2.Token) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName(userID),
+			Namespace: namespace,
+			Labels: map[string]string{
+				LabelManagedBy: "powergrid",
+				LabelUserID:    userID,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"access_token":  []byte(token.AccessToken),
+			"token_type":    []byte(token.TokenType),
+			"refresh_token": []byte(token.RefreshToken),
+			"expiry":        []byte(token.Expiry.Format(time.RFC3339)),
+		},
+	}
+
+	secrets := kubernetesClient.CoreV1().Secrets(namespace)
+	_, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package linkedroles
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// RoleConnectionPath is the internal API path prefix for reading and pushing user role connections, followed by the user ID.
+const RoleConnectionPath = "/role-connections/"
+
+// HandleRoleConnection serves the internal API used by services to read (GET) or replace (PUT) a user's role connection metadata.
+// The request and response bodies are discordgo.ApplicationRoleConnection objects.
+// Requests must have env.RoleConnectionsToken as their bearer token, as any pod in the cluster can reach the internal API.
+func HandleRoleConnection(w http.ResponseWriter, r *http.Request) {
+	if env.RoleConnectionsToken == "" {
+		http.Error(w, "role connections API is disabled", http.StatusNotFound)
+		return
+	}
+	bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(bearer), []byte(env.RoleConnectionsToken)) != 1 {
+		slog.Warn("unauthorized role connections request", utils.Tag("role_connections_unauthorized"), slog.String("ip", utils.GetIP(r)), slog.String("path", r.URL.Path))
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != "GET" && r.Method != "PUT" {
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !Enabled() {
+		http.Error(w, "linked roles are disabled", http.StatusNotFound)
+		return
+	}
+
+	userID := strings.TrimPrefix(r.URL.Path, RoleConnectionPath)
+	if userID == "" || strings.Contains(userID, "/") {
+		http.Error(w, "invalid user id", http.StatusBadRequest)
+		return
+	}
+	log := slog.With(slog.String("user", userID))
+
+	var connection *discordgo.ApplicationRoleConnection
+	if r.Method == "PUT" {
+		connection = &discordgo.ApplicationRoleConnection{}
+		err := json.NewDecoder(r.Body).Decode(connection)
+		if err != nil {
+			http.Error(w, "failed to unmarshal json", http.StatusBadRequest)
+			return
+		}
+	}
+
+	token, err := store.Get(r.Context(), userID)
+	if errors.Is(err, ErrTokenNotFound) {
+		http.Error(w, "user has not linked their account", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Error("failed to get oauth token", utils.Tag("linked_roles_store_failed"), utils.Error(err))
+		http.Error(w, "failed to get oauth token", http.StatusInternalServerError)
+		return
+	}
+
+	refreshed, err := config.TokenSource(r.Context(), token).Token()
+	if err != nil {
+		log.Error("failed to refresh oauth token", utils.Tag("linked_roles_refresh_failed"), utils.Error(err))
+		http.Error(w, "failed to refresh oauth token", http.StatusBadGateway)
+		return
+	}
+	if refreshed.AccessToken != token.AccessToken {
+		err = store.Set(r.Context(), userID, refreshed)
+		if err != nil {
+			log.Error("failed to store oauth token", utils.Tag("linked_roles_store_failed"), utils.Error(err))
+		}
+	}
+
+	endpoint := discordgo.EndpointUserApplicationRoleConnection(env.DiscordApplicationID)
+	var data interface{}
+	if connection != nil {
+		data = connection
+	}
+	body, err := discord.Session.RequestWithBucketID(r.Method, endpoint, data, endpoint,
+		discordgo.WithContext(r.Context()),
+		discordgo.WithHeader("Authorization", refreshed.Type()+" "+refreshed.AccessToken))
+	if err != nil {
+		log.Error("failed to update role connection", utils.Tag("linked_roles_update_failed"), utils.Error(err), slog.String("connection", utils.TryMarshal(connection)))
+		http.Error(w, "failed to update role connection", http.StatusBadGateway)
+		return
+	}
+
+	if connection != nil {
+		log.Info("updated role connection", utils.Tag("linked_roles_updated"))
+	}
+	w.Header().Set("Content-Type", utils.MimeTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,122 @@
+package linkedroles
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/internal/coordinator/discord"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"golang.org/x/oauth2"
+	"log/slog"
+	"net/http"
+)
+
+const stateCookieName = "powergrid_linked_roles_state"
+
+const (
+	LinkedMessage = "Your account has been linked. You can now close this tab and return to Discord."
+	FailedMessage = "Failed to link your account. Please try again."
+)
+
+var store TokenStore
+var config *oauth2.Config
+
+// Enabled indicates whether linked roles have been configured.
+func Enabled() bool {
+	return config != nil
+}
+
+// Init configures the linked roles OAuth2 flow with the given token store.
+// Linked roles are left disabled if env.DiscordOAuthRedirectURI is unset.
+func Init(tokenStore TokenStore) {
+	if env.DiscordOAuthRedirectURI == "" {
+		slog.Info("linked roles disabled", utils.Tag("linked_roles_disabled"))
+		return
+	}
+
+	store = tokenStore
+	config = &oauth2.Config{
+		ClientID:     env.DiscordApplicationID,
+		ClientSecret: env.DiscordOAuthSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  "https://discord.com/oauth2/authorize",
+			TokenURL: discord.OAuthTokenURL,
+		},
+		RedirectURL: env.DiscordOAuthRedirectURI,
+		Scopes:      []string{"identify", "role_connections.write"},
+	}
+	slog.Info("linked roles enabled", utils.Tag("linked_roles_enabled"), slog.String("redirect_uri", env.DiscordOAuthRedirectURI))
+}
+
+// HandleVerify serves the linked roles verification URL, redirecting the user to Discord's OAuth2 consent screen.
+func HandleVerify(w http.ResponseWriter, r *http.Request) {
+	buf := make([]byte, 16)
+	_, err := rand.Read(buf)
+	if err != nil {
+		slog.Error("failed to generate oauth state", utils.Tag("linked_roles_state_failed"), utils.Error(err))
+		http.Error(w, FailedMessage, http.StatusInternalServerError)
+		return
+	}
+	state := hex.EncodeToString(buf)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookieName,
+		Value:    state,
+		Path:     "/linked-roles",
+		MaxAge:   300,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, config.AuthCodeURL(state, oauth2.SetAuthURLParam("prompt", "consent")), http.StatusFound)
+}
+
+// HandleCallback serves the OAuth2 redirect URI, storing the user's token.
+func HandleCallback(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(stateCookieName)
+	if err != nil || cookie.Value == "" || cookie.Value != r.URL.Query().Get("state") {
+		slog.Warn("invalid oauth state", utils.Tag("linked_roles_invalid_state"), slog.String("ip", utils.GetIP(r)))
+		http.Error(w, FailedMessage, http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   stateCookieName,
+		Path:   "/linked-roles",
+		MaxAge: -1,
+	})
+
+	token, err := config.Exchange(r.Context(), r.URL.Query().Get("code"))
+	if err != nil {
+		slog.Error("failed to exchange oauth code", utils.Tag("linked_roles_exchange_failed"), utils.Error(err))
+		http.Error(w, FailedMessage, http.StatusBadRequest)
+		return
+	}
+
+	user := &discordgo.User{}
+	body, err := discord.Session.RequestWithBucketID("GET", discordgo.EndpointUser("@me"), nil, discordgo.EndpointUser("@me"),
+		discordgo.WithContext(r.Context()),
+		discordgo.WithHeader("Authorization", token.Type()+" "+token.AccessToken))
+	if err == nil {
+		err = json.Unmarshal(body, user)
+	}
+	if err != nil {
+		slog.Error("failed to get oauth user", utils.Tag("linked_roles_user_failed"), utils.Error(err))
+		http.Error(w, FailedMessage, http.StatusInternalServerError)
+		return
+	}
+	log := slog.With(slog.String("user", user.ID))
+
+	err = store.Set(r.Context(), user.ID, token)
+	if err != nil {
+		log.Error("failed to store oauth token", utils.Tag("linked_roles_store_failed"), utils.Error(err))
+		http.Error(w, FailedMessage, http.StatusInternalServerError)
+		return
+	}
+
+	log.Info("user linked account", utils.Tag("linked_roles_linked"))
+	w.Header().Set("Content-Type", utils.MimeTypeText)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(LinkedMessage))
+}
@@ -0,0 +1,37 @@
+package linkedroles
+
+import (
+	"context"
+	"errors"
+	"golang.org/x/oauth2"
+	"sync"
+)
+
+// ErrTokenNotFound is returned by a TokenStore when the user has not linked their account.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenStore persists the OAuth2 tokens of users who have linked their account.
+type TokenStore interface {
+	// Get returns the token for the user, or ErrTokenNotFound.
+	Get(ctx context.Context, userID string) (*oauth2.Token, error)
+	// Set creates or replaces the token for the user.
+	Set(ctx context.Context, userID string, token *oauth2.Token) error
+}
+
+// MemoryTokenStore is a TokenStore which keeps tokens in memory. Tokens are lost when the coordinator restarts, and are not shared between replicas.
+type MemoryTokenStore struct {
+	tokens sync.Map
+}
+
+func (s *MemoryTokenStore) Get(_ context.Context, userID string) (*oauth2.Token, error) {
+	token, ok := s.tokens.Load(userID)
+	if !ok {
+		return nil, ErrTokenNotFound
+	}
+	return token.(*oauth2.Token), nil
+}
+
+func (s *MemoryTokenStore) Set(_ context.Context, userID string, token *oauth2.Token) error {
+	s.tokens.Store(userID, token)
+	return nil
+}
@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+const namespace = "powergrid"
+
+const (
+	ResultOK            = "ok"
+	ResultUpstreamError = "upstream_error"
+	ResultFailed        = "failed"
+)
+
+// ForwardedInteractions counts interactions forwarded to services.
+// route is the command name or custom_id prefix, and result is one of ResultOK, ResultUpstreamError or ResultFailed.
+var ForwardedInteractions = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "forwarded_interactions_total",
+	Help:      "Number of interactions forwarded to services, by route, service and result.",
+}, []string{"route", "service", "result"})
+
+// ForwardDuration observes the time taken for services to respond to forwarded interactions.
+var ForwardDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "forward_duration_seconds",
+	Help:      "Time taken for services to respond to forwarded interactions, by route and service.",
+	Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 3, 5, 10},
+}, []string{"route", "service"})
+
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// AutocompleteCacheRequests counts autocomplete interactions of Commands with an autocompleteCache, by whether a cached response was used.
+var AutocompleteCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "autocomplete_cache_requests_total",
+	Help:      "Number of autocomplete interactions answered from the cache or forwarded, by route and result.",
+}, []string{"route", "result"})
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
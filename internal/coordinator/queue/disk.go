@@ -0,0 +1,200 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// diskConcurrency is the maximum number of deliveries handled at once by a DiskQueue.
+const diskConcurrency = 32
+
+// DiskQueue is a Queue which keeps each delivery in a file. It is not shared between replicas,
+// so the directory should be a persistent volume for deliveries to survive the pod being replaced.
+type DiskQueue struct {
+	dir  string
+	wake chan struct{}
+
+	mutex    sync.Mutex
+	inFlight map[string]bool
+}
+
+var _ Queue = &DiskQueue{}
+
+// diskEntry is the contents of a delivery's file.
+type diskEntry struct {
+	Delivery    *Delivery `json:"delivery"`
+	NextAttempt time.Time `json:"nextAttempt"`
+}
+
+func NewDiskQueue(dir string) (*DiskQueue, error) {
+	err := os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return nil, err
+	}
+	return &DiskQueue{
+		dir:      dir,
+		wake:     make(chan struct{}, 1),
+		inFlight: make(map[string]bool),
+	}, nil
+}
+
+func (q *DiskQueue) path(id string) string {
+	return filepath.Join(q.dir, id+".json")
+}
+
+// write atomically replaces the file of the delivery.
+func (q *DiskQueue) write(entry *diskEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(q.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(b)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), q.path(entry.Delivery.ID))
+}
+
+func (q *DiskQueue) Enqueue(_ context.Context, d *Delivery) error {
+	err := q.write(&diskEntry{
+		Delivery:    d,
+		NextAttempt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (q *DiskQueue) Consume(ctx context.Context, handle Handler) error {
+	sem := make(chan struct{}, diskConcurrency)
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		entries, err := q.due()
+		if err != nil {
+			slog.Error("failed to read delivery queue", utils.Tag("queue_read_failed"), utils.Error(err), slog.String("dir", q.dir))
+		}
+
+		for _, entry := range entries {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return nil
+			}
+
+			wg.Add(1)
+			go func(entry *diskEntry) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				q.process(ctx, entry, handle)
+			}(entry)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// due reads the deliveries which are ready for another attempt, and marks them as in flight.
+func (q *DiskQueue) due() ([]*diskEntry, error) {
+	files, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	var entries []*diskEntry
+	var errs []error
+	now := time.Now()
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		id := strings.TrimSuffix(name, ".json")
+		if q.inFlight[id] {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(q.dir, name))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		entry := &diskEntry{}
+		err = json.Unmarshal(b, entry)
+		if err != nil || entry.Delivery == nil {
+			slog.Error("removing invalid delivery", utils.Tag("queue_delivery_invalid"), utils.Error(err), slog.String("file", name))
+			_ = os.Remove(filepath.Join(q.dir, name))
+			continue
+		}
+		if entry.NextAttempt.After(now) {
+			continue
+		}
+
+		q.inFlight[id] = true
+		entries = append(entries, entry)
+	}
+	return entries, errors.Join(errs...)
+}
+
+func (q *DiskQueue) process(ctx context.Context, entry *diskEntry, handle Handler) {
+	id := entry.Delivery.ID
+	defer func() {
+		q.mutex.Lock()
+		delete(q.inFlight, id)
+		q.mutex.Unlock()
+	}()
+
+	retry, delay := attempt(ctx, entry.Delivery, handle)
+	if !retry {
+		err := os.Remove(q.path(id))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			slog.Error("failed to remove delivery", utils.Tag("queue_remove_failed"), utils.Error(err), slog.String("id", id))
+		}
+		return
+	}
+
+	entry.NextAttempt = time.Now().Add(delay)
+	err := q.write(entry)
+	if err != nil {
+		slog.Error("failed to update delivery", utils.Tag("queue_write_failed"), utils.Error(err), slog.String("id", id))
+	}
+}
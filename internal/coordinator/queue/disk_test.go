@@ -0,0 +1,71 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDiskQueueRetryKeepsRequestHeadersOut(t *testing.T) {
+	q, err := NewDiskQueue(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Delivery{
+		ID:         "1",
+		URL:        "https://example.com/interactions",
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       []byte(`{}`),
+		External:   "default/ping",
+		ReceivedAt: time.Now(),
+	}
+	err = q.Enqueue(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	attempted := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- q.Consume(ctx, func(ctx context.Context, d *Delivery, last bool) error {
+			defer close(attempted)
+			req, err := d.NewRequest(ctx)
+			if err != nil {
+				return err
+			}
+			// as deliver does for external backends, with a value read from a Secret
+			req.Header.Set("Authorization", "Bearer secret")
+			return errors.New("upstream unavailable")
+		})
+	}()
+
+	select {
+	case <-attempted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery was not attempted")
+	}
+	cancel()
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(q.path(d.ID))
+	if err != nil {
+		t.Fatalf("delivery was not written back: %v", err)
+	}
+	entry := &diskEntry{}
+	if err = json.Unmarshal(b, entry); err != nil {
+		t.Fatal(err)
+	}
+	if value := entry.Delivery.Header.Get("Authorization"); value != "" {
+		t.Errorf("written back delivery has Authorization header %q", value)
+	}
+	if value := entry.Delivery.Header.Get("Content-Type"); value != "application/json" {
+		t.Errorf("written back delivery has Content-Type %q, want application/json", value)
+	}
+}
@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	natsStream   = "POWERGRID_DELIVERIES"
+	natsSubject  = "powergrid.deliveries"
+	natsConsumer = "coordinator"
+	// natsAckWait is how long a delivery can go without progress being reported before it is redelivered.
+	natsAckWait = 30 * time.Second
+)
+
+// NATSQueue is a Queue backed by a NATS JetStream work queue, shared between replicas.
+type NATSQueue struct {
+	conn     *nats.Conn
+	js       jetstream.JetStream
+	consumer jetstream.Consumer
+}
+
+var _ Queue = &NATSQueue{}
+
+func NewNATSQueue(url string) (*NATSQueue, error) {
+	conn, err := nats.Connect(url, nats.Name("powergrid-coordinator"))
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      natsStream,
+		Subjects:  []string{natsSubject},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+		MaxAge:    TokenLifetime,
+		// deduplicate deliveries by interaction ID
+		Duplicates: TokenLifetime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:   natsConsumer,
+		AckPolicy: jetstream.AckExplicitPolicy,
+		AckWait:   natsAckWait,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATSQueue{
+		conn:     conn,
+		js:       js,
+		consumer: consumer,
+	}, nil
+}
+
+func (q *NATSQueue) Enqueue(ctx context.Context, d *Delivery) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	_, err = q.js.Publish(ctx, natsSubject, b, jetstream.WithMsgID(d.ID))
+	return err
+}
+
+func (q *NATSQueue) Consume(ctx context.Context, handle Handler) error {
+	wg := &sync.WaitGroup{}
+	consumeCtx, err := q.consumer.Consume(func(msg jetstream.Msg) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.process(ctx, msg, handle)
+		}()
+	})
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	consumeCtx.Stop()
+	wg.Wait()
+	return q.conn.Drain()
+}
+
+func (q *NATSQueue) process(ctx context.Context, msg jetstream.Msg, handle Handler) {
+	d := &Delivery{}
+	err := json.Unmarshal(msg.Data(), d)
+	if err != nil {
+		slog.Error("removing invalid delivery", utils.Tag("queue_delivery_invalid"), utils.Error(err))
+		_ = msg.Term()
+		return
+	}
+	if metadata, err := msg.Metadata(); err == nil {
+		d.Attempts = int(metadata.NumDelivered) - 1
+	}
+
+	// keep the message from being redelivered while the service is handling it
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(natsAckWait / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = msg.InProgress()
+			}
+		}
+	}()
+
+	retry, delay := attempt(ctx, d, handle)
+	if retry {
+		err = msg.NakWithDelay(delay)
+	} else {
+		err = msg.Ack()
+	}
+	if err != nil {
+		slog.Error("failed to acknowledge delivery", utils.Tag("queue_ack_failed"), utils.Error(err), slog.String("id", d.ID))
+	}
+}
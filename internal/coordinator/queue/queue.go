@@ -0,0 +1,151 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// TokenLifetime is how long the interaction token of a delivery is valid for. Deliveries are dropped after it expires.
+const TokenLifetime = 15 * time.Minute
+
+const (
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+// Delivery is a deferred interaction waiting to be sent to its service.
+type Delivery struct {
+	// ID is the ID of the interaction.
+	ID            string `json:"id"`
+	ApplicationID string `json:"applicationID"`
+	Token         string `json:"token"`
+
+	URL  string `json:"url"`
+	Host string `json:"host"`
+	// Header is the headers of the request, without the headers of an external backend.
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+	// External is the namespace/name of the Command, if the delivery is sent to its external backend.
+	// The backend's headers can be read from Secrets, so they aren't stored and are added when the delivery is attempted.
+	External string `json:"external,omitempty"`
+
+	// Route and Service are used for logs and metrics.
+	Route   string `json:"route"`
+	Service string `json:"service"`
+
+	// Audit is the JSON of the audit record of the interaction, if its Command is audited. It is written to AuditSinks once the delivery
+	// succeeds or fails for good, with Secrets referenced by the sinks read from AuditNamespace.
+	Audit          json.RawMessage          `json:"audit,omitempty"`
+	AuditSinks     []powergridv10.AuditSink `json:"auditSinks,omitempty"`
+	AuditNamespace string                   `json:"auditNamespace,omitempty"`
+
+	ReceivedAt time.Time `json:"receivedAt"`
+	// Attempts is the number of failed attempts so far.
+	Attempts int `json:"attempts"`
+}
+
+// Deadline is when the interaction token expires.
+func (d *Delivery) Deadline() time.Time {
+	return d.ReceivedAt.Add(TokenLifetime)
+}
+
+// NewRequest builds the request of the delivery. Its headers are a copy, so headers added to the request,
+// such as those of an external backend, aren't written back to the queue.
+func (d *Delivery) NewRequest(ctx context.Context) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = d.Header.Clone()
+	req.Host = d.Host
+	return req, nil
+}
+
+// Handler sends a delivery to its service. Deliveries are retried if an error is returned.
+// last is set on the final attempt before the interaction token expires.
+type Handler func(ctx context.Context, d *Delivery, last bool) error
+
+// Queue durably stores deliveries until they are handled, which may be shared between replicas.
+type Queue interface {
+	// Enqueue stores the delivery, returning once it is durable.
+	Enqueue(ctx context.Context, d *Delivery) error
+	// Consume calls handle for deliveries until ctx is done.
+	Consume(ctx context.Context, handle Handler) error
+}
+
+var queue Queue
+
+// Init creates the delivery queue of the kind, either "disk" with deliveries in dir, or "nats" connecting to natsURL.
+// No queue is created if kind is empty.
+func Init(kind string, dir string, natsURL string) {
+	var err error
+	switch kind {
+	case "":
+		return
+	case "disk":
+		queue, err = NewDiskQueue(dir)
+	case "nats":
+		queue, err = NewNATSQueue(natsURL)
+	}
+	if err != nil {
+		slog.Error("failed to create delivery queue", utils.Tag("queue_failed"), utils.Error(err), slog.String("queue", kind))
+		os.Exit(1)
+	}
+	slog.Info("using delivery queue", utils.Tag("queue_created"), slog.String("queue", kind))
+}
+
+// Enabled indicates whether deferred interactions should be enqueued.
+func Enabled() bool {
+	return queue != nil
+}
+
+// Enqueue stores the delivery. See Queue.Enqueue.
+func Enqueue(ctx context.Context, d *Delivery) error {
+	return queue.Enqueue(ctx, d)
+}
+
+// Consume calls handle for deliveries until ctx is done. See Queue.Consume.
+func Consume(ctx context.Context, handle Handler) error {
+	return queue.Consume(ctx, handle)
+}
+
+// Backoff is the delay before retrying a delivery which has failed the given number of times.
+func Backoff(attempts int) time.Duration {
+	if attempts >= 7 {
+		return maxBackoff
+	}
+	return min(minBackoff<<attempts, maxBackoff)
+}
+
+// attempt calls handle for the delivery, and returns whether it should be retried after delay.
+func attempt(ctx context.Context, d *Delivery, handle Handler) (bool, time.Duration) {
+	log := slog.With(slog.String("id", d.ID), slog.Int("attempts", d.Attempts))
+	if time.Now().After(d.Deadline()) {
+		log.Warn("dropping expired delivery", utils.Tag("queue_delivery_expired"), slog.Time("received_at", d.ReceivedAt))
+		return false, 0
+	}
+
+	last := time.Now().Add(Backoff(d.Attempts + 1)).After(d.Deadline())
+	err := handle(ctx, d, last)
+	if err == nil {
+		return false, 0
+	}
+	if ctx.Err() != nil {
+		// interrupted by shutdown, so retry without counting the attempt
+		return true, 0
+	}
+
+	d.Attempts++
+	if last {
+		log.Error("giving up on delivery", utils.Tag("queue_delivery_failed"), utils.Error(err))
+		return false, 0
+	}
+	return true, Backoff(d.Attempts)
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often full buckets are removed from a MemoryStore.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	period time.Duration
+}
+
+// MemoryStore is a Store which keeps buckets in memory. Buckets are not shared between replicas.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, buckets []Bucket) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > sweepInterval {
+		s.sweep(now)
+	}
+
+	var wait time.Duration
+	limited := false
+	refilled := make([]*bucket, len(buckets))
+	for i, limit := range buckets {
+		b, ok := s.buckets[limit.Key]
+		if !ok {
+			b = &bucket{tokens: float64(limit.Limit), last: now}
+			s.buckets[limit.Key] = b
+		}
+		b.period = limit.Period
+
+		rate := float64(limit.Limit) / float64(limit.Period)
+		b.tokens = min(float64(limit.Limit), b.tokens+float64(now.Sub(b.last))*rate)
+		b.last = now
+		if b.tokens < 1 {
+			limited = true
+			wait = max(wait, time.Duration((1-b.tokens)/rate))
+		}
+		refilled[i] = b
+	}
+
+	// tokens are only taken once every bucket is known to have one
+	if limited {
+		return max(wait, time.Millisecond), nil
+	}
+	for _, b := range refilled {
+		b.tokens--
+	}
+	return 0, nil
+}
+
+// sweep removes buckets which have refilled completely, as they are equivalent to a new bucket.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.last) >= b.period {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
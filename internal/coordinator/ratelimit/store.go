@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/sportshead/powergrid/internal/coordinator/env"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// Bucket is a token bucket with the key, which refills with Limit tokens every Period.
+type Bucket struct {
+	Key    string
+	Limit  int
+	Period time.Duration
+}
+
+// Store keeps token buckets, which may be shared between replicas.
+type Store interface {
+	// Take removes a token from every bucket if all of them have a token, and otherwise leaves them unchanged.
+	// Returns the longest time until a token is available in an empty bucket, or 0 if the tokens were taken.
+	Take(ctx context.Context, buckets []Bucket) (time.Duration, error)
+}
+
+var store Store
+
+// Init creates the rate limit store, using Redis if env.RedisURL is set.
+func Init() {
+	if env.RedisURL == "" {
+		store = NewMemoryStore()
+		slog.Info("using in-memory rate limit store", utils.Tag("ratelimit_memory"))
+		return
+	}
+
+	var err error
+	store, err = NewRedisStore(env.RedisURL)
+	if err != nil {
+		slog.Error("failed to create redis rate limit store", utils.Tag("ratelimit_redis_failed"), utils.Error(err))
+		os.Exit(1)
+	}
+	slog.Info("using redis rate limit store", utils.Tag("ratelimit_redis"))
+}
+
+// Take removes a token from every bucket if all of them have a token. See Store.Take.
+func Take(ctx context.Context, buckets []Bucket) (time.Duration, error) {
+	return store.Take(ctx, buckets)
+}
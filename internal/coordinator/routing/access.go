@@ -0,0 +1,54 @@
+package routing
+
+import (
+	"github.com/bwmarrin/discordgo"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"slices"
+	"strconv"
+)
+
+// MatchesRule checks whether the interaction matches all the fields of the rule.
+// Rules with invalid permissions match if invalid is set, so that deny rules fail closed.
+func MatchesRule(rule powergridv10.AccessRule, interaction *discordgo.Interaction, invalid bool) bool {
+	if len(rule.Users) > 0 && !slices.Contains(rule.Users, UserID(interaction)) {
+		return false
+	}
+	if len(rule.Guilds) > 0 && !slices.Contains(rule.Guilds, interaction.GuildID) {
+		return false
+	}
+	if len(rule.Channels) > 0 && !slices.Contains(rule.Channels, interaction.ChannelID) {
+		return false
+	}
+	if len(rule.Roles) > 0 {
+		if interaction.Member == nil || !slices.ContainsFunc(interaction.Member.Roles, func(role string) bool {
+			return slices.Contains(rule.Roles, role)
+		}) {
+			return false
+		}
+	}
+	if rule.Permissions != "" {
+		permissions, err := strconv.ParseInt(rule.Permissions, 10, 64)
+		if err != nil {
+			return invalid
+		}
+		if interaction.Member == nil || interaction.Member.Permissions&permissions != permissions {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowedByPolicy checks whether the policy allows the interaction.
+func AllowedByPolicy(policy *powergridv10.AccessPolicy, interaction *discordgo.Interaction) bool {
+	for _, rule := range policy.Spec.Deny {
+		if MatchesRule(rule, interaction, true) {
+			return false
+		}
+	}
+	if len(policy.Spec.Allow) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(policy.Spec.Allow, func(rule powergridv10.AccessRule) bool {
+		return MatchesRule(rule, interaction, false)
+	})
+}
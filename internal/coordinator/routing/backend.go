@@ -0,0 +1,55 @@
+package routing
+
+import (
+	"github.com/bwmarrin/discordgo"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"hash/fnv"
+	"math/rand"
+)
+
+// SelectService picks the service to forward a command's interaction to.
+// With StickyBy set, the same user or guild is always sent to the same backend, as long as the weights are unchanged.
+func SelectService(cmd *powergridv10.Command, interaction *discordgo.Interaction) string {
+	backends := cmd.Spec.Backends
+	if len(backends) == 0 {
+		return cmd.Spec.ServiceName
+	}
+
+	var total uint64
+	for _, backend := range backends {
+		if backend.Weight > 0 {
+			total += uint64(backend.Weight)
+		}
+	}
+	if total == 0 {
+		return backends[0].ServiceName
+	}
+
+	var id string
+	switch cmd.Spec.StickyBy {
+	case powergridv10.StickyByUser:
+		id = UserID(interaction)
+	case powergridv10.StickyByGuild:
+		id = interaction.GuildID
+	}
+
+	var n uint64
+	if id != "" {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(id))
+		n = h.Sum64() % total
+	} else {
+		n = rand.Uint64() % total
+	}
+
+	for _, backend := range backends {
+		if backend.Weight <= 0 {
+			continue
+		}
+		if n < uint64(backend.Weight) {
+			return backend.ServiceName
+		}
+		n -= uint64(backend.Weight)
+	}
+	return backends[len(backends)-1].ServiceName
+}
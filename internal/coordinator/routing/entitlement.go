@@ -0,0 +1,33 @@
+package routing
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+type interactionEntitlements struct {
+	Entitlements []struct {
+		SKUID string `json:"sku_id"`
+	} `json:"entitlements"`
+}
+
+// HasEntitlement checks whether the interaction has an entitlement to any of the SKUs.
+// Returns true if no SKUs are required.
+func HasEntitlement(body []byte, skus []string) (bool, error) {
+	if len(skus) == 0 {
+		return true, nil
+	}
+
+	data := &interactionEntitlements{}
+	err := json.Unmarshal(body, data)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entitlement := range data.Entitlements {
+		if slices.Contains(skus, entitlement.SKUID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
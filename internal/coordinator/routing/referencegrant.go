@@ -0,0 +1,18 @@
+package routing
+
+import (
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"slices"
+)
+
+// GrantAllows checks whether the grant, which is in the service's namespace, allows resources in the from namespace to reference the service.
+func GrantAllows(grant *powergridv10.ReferenceGrant, from string, serviceName string) bool {
+	if !slices.ContainsFunc(grant.Spec.From, func(f powergridv10.ReferenceGrantFrom) bool {
+		return f.Namespace == from
+	}) {
+		return false
+	}
+	return len(grant.Spec.To) == 0 || slices.ContainsFunc(grant.Spec.To, func(t powergridv10.ReferenceGrantTo) bool {
+		return t.Name == serviceName
+	})
+}
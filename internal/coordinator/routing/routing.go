@@ -0,0 +1,55 @@
+// Package routing decides where interactions are sent, without looking anything up in the cluster.
+// It is shared by the coordinator and powergridctl simulate.
+package routing
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// UserID returns the ID of the user who triggered the interaction, in either a guild or a DM.
+func UserID(interaction *discordgo.Interaction) string {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User.ID
+	}
+	if interaction.User != nil {
+		return interaction.User.ID
+	}
+	return ""
+}
+
+// CommandPath expands the path template of a command, defaulting to "/".
+func CommandPath(template string, name string) string {
+	if template == "" {
+		return "/"
+	}
+	return strings.ReplaceAll(template, "{command}", url.PathEscape(name))
+}
+
+// ComponentPath expands the path template of a component route, defaulting to "/".
+func ComponentPath(template string, prefix string) string {
+	if template == "" {
+		return "/"
+	}
+	return strings.ReplaceAll(template, "{prefix}", url.PathEscape(prefix))
+}
+
+// ParseServiceRef splits a reference to a service, which is either a name or namespace/name.
+// defaultNamespace is used if the reference has no namespace.
+func ParseServiceRef(defaultNamespace, ref string) (string, string) {
+	if ns, name, ok := strings.Cut(ref, "/"); ok {
+		return ns, name
+	}
+	return defaultNamespace, ref
+}
+
+func isGuildIDSeparator(r rune) bool {
+	return r == ',' || unicode.IsSpace(r)
+}
+
+// GuildIDs splits a list of guild IDs from a ConfigMap, separated by commas or whitespace.
+func GuildIDs(value string) []string {
+	return strings.FieldsFunc(value, isGuildIDSeparator)
+}
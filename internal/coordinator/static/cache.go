@@ -0,0 +1,39 @@
+package static
+
+import (
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"sync"
+)
+
+// cached is the result of parsing the response of a generation of a Command.
+type cached struct {
+	generation int64
+	templates  *Templates
+	err        error
+}
+
+// cache holds the parsed response of the latest generation of each Command, keyed by its UID.
+var cache = make(map[string]cached)
+var cacheMutex sync.Mutex
+
+// Get returns the parsed templates of the Command's response, which are only parsed once for each generation.
+// Parse errors are cached as well, so that broken templates are only parsed again once the Command changes.
+func Get(cmd *powergridv10.Command) (*Templates, error) {
+	key := string(cmd.UID)
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	if c, ok := cache[key]; ok && c.generation == cmd.Generation {
+		return c.templates, c.err
+	}
+
+	templates, err := Parse(cmd.Spec.Response)
+	cache[key] = cached{generation: cmd.Generation, templates: templates, err: err}
+	return templates, err
+}
+
+// Forget drops the parsed response of the Command, once it is deleted.
+func Forget(cmd *powergridv10.Command) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	delete(cache, string(cmd.UID))
+}
@@ -0,0 +1,184 @@
+// Package static renders the inline responses of Commands, which the coordinator answers without a service.
+package static
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Data is what the templates of a response are rendered with.
+type Data struct {
+	// User is the user who used the command, e.g. {{.User.Username}} or {{.User.Mention}}
+	User *discordgo.User
+	// Member is the user's guild member, which is nil in DMs, e.g. {{with .Member}}{{.Nick}}{{end}}
+	Member    *discordgo.Member
+	GuildID   string
+	ChannelID string
+	Locale    discordgo.Locale
+	// Command is the full name of the command, e.g. "mod ban"
+	Command string
+	// Options are the values of the options by name. Users, channels and roles are IDs, e.g. <@{{.Options.user}}>
+	Options map[string]interface{}
+}
+
+// NewData returns the data of the command interaction.
+func NewData(interaction *discordgo.Interaction) *Data {
+	name, options := powergrid.FullCommand(interaction.ApplicationCommandData())
+	data := &Data{
+		User:      interaction.User,
+		Member:    interaction.Member,
+		GuildID:   interaction.GuildID,
+		ChannelID: interaction.ChannelID,
+		Locale:    interaction.Locale,
+		Command:   strings.Join(name, " "),
+		Options:   make(map[string]interface{}, len(options)),
+	}
+	if interaction.Member != nil {
+		data.User = interaction.Member.User
+	}
+	for _, option := range options {
+		data.Options[option.Name] = option.Value
+	}
+	return data
+}
+
+// Templates are the parsed templates of a response, by their path, e.g. embeds[0].title
+type Templates struct {
+	response  *powergridv10.StaticResponse
+	templates map[string]*template.Template
+}
+
+// Parse parses every template of the response, and its embeds and components.
+func Parse(response *powergridv10.StaticResponse) (*Templates, error) {
+	t := &Templates{response: response, templates: make(map[string]*template.Template)}
+	_, err := walk(response, func(name string, text string) (string, error) {
+		if !strings.Contains(text, "{{") {
+			return text, nil
+		}
+		parsed, err := parse(name, text)
+		if err != nil {
+			return text, err
+		}
+		t.templates[name] = parsed
+		return text, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Render returns the JSON of the interaction response to the command interaction, with every template of the response executed.
+func (t *Templates) Render(interaction *discordgo.Interaction) ([]byte, error) {
+	data := NewData(interaction)
+	res, err := walk(t.response, func(name string, text string) (string, error) {
+		parsed, ok := t.templates[name]
+		if !ok {
+			return text, nil
+		}
+		var b strings.Builder
+		err := parsed.Execute(&b, data)
+		return b.String(), err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"type": discordgo.InteractionResponseChannelMessageWithSource,
+		"data": res,
+	})
+}
+
+// Render parses the templates of the response, and renders it for the command interaction. See Templates.Render.
+func Render(response *powergridv10.StaticResponse, interaction *discordgo.Interaction) ([]byte, error) {
+	t, err := Parse(response)
+	if err != nil {
+		return nil, err
+	}
+	return t.Render(interaction)
+}
+
+// Validate parses every template of the response, and its embeds and components.
+func Validate(response *powergridv10.StaticResponse) error {
+	_, err := Parse(response)
+	return err
+}
+
+func parse(name string, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=zero").Parse(text)
+}
+
+// walk returns the interaction callback data of the response, with every string replaced by f, which is passed its path, e.g. embeds[0].title
+func walk(response *powergridv10.StaticResponse, f func(path string, text string) (string, error)) (map[string]interface{}, error) {
+	var errs []error
+	data := map[string]interface{}{
+		// mentions are shown without notifying anyone, as options can contain any text
+		"allowed_mentions": map[string]interface{}{"parse": []string{}},
+	}
+	if response.Content != "" {
+		content, err := f("content", response.Content)
+		errs = append(errs, err)
+		data["content"] = content
+	}
+	if response.Ephemeral {
+		data["flags"] = discordgo.MessageFlagsEphemeral
+	}
+
+	for _, field := range []struct {
+		name   string
+		values []apiextensionsv1.JSON
+	}{{"embeds", response.Embeds}, {"components", response.Components}} {
+		if len(field.values) == 0 {
+			continue
+		}
+		values := make([]interface{}, len(field.values))
+		for i, raw := range field.values {
+			path := fmt.Sprintf("%s[%d]", field.name, i)
+			var value interface{}
+			err := json.Unmarshal(raw.Raw, &value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", path, err))
+				continue
+			}
+			values[i], err = walkValue(path, value, f)
+			errs = append(errs, err)
+		}
+		data[field.name] = values
+	}
+	return data, errors.Join(errs...)
+}
+
+func walkValue(path string, value interface{}, f func(path string, text string) (string, error)) (interface{}, error) {
+	var errs []error
+	switch v := value.(type) {
+	case string:
+		return f(path, v)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			var err error
+			v[key], err = walkValue(path+"."+key, v[key], f)
+			errs = append(errs, err)
+		}
+	case []interface{}:
+		for i := range v {
+			var err error
+			v[i], err = walkValue(fmt.Sprintf("%s[%d]", path, i), v[i], f)
+			errs = append(errs, err)
+		}
+	}
+	return value, errors.Join(errs...)
+}
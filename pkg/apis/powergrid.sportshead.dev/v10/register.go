@@ -32,6 +32,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Command{},
 		&CommandList{},
+		&RoleConnectionMetadataRecord{},
+		&RoleConnectionMetadataRecordList{},
+		&EventSubscription{},
+		&EventSubscriptionList{},
+		&ComponentRoute{},
+		&ComponentRouteList{},
+		&AccessPolicy{},
+		&AccessPolicyList{},
+		&ReferenceGrant{},
+		&ReferenceGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -3,6 +3,7 @@ package v10
 import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +genclient
@@ -15,7 +16,8 @@ type Command struct {
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
 	metav1.ObjectMeta `json:"metadata"`
 
-	Spec CommandSpec `json:"spec"`
+	Spec   CommandSpec   `json:"spec"`
+	Status CommandStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -30,12 +32,432 @@ type CommandList struct {
 	Items []Command `json:"items"`
 }
 
+// CommandStatus is the status of a Command resource, written by the coordinator.
+type CommandStatus struct {
+	// PermissionGuilds are the guilds whose permission overwrites were last applied, which are reset once removed from the spec.
+	PermissionGuilds []string `json:"permissionGuilds,omitempty"`
+}
+
 // CommandSpec is the spec of a Command resource.
 type CommandSpec struct {
 	// ShouldSendDeferred indicates whether to respond with an initial deferred message to Discord. If true, any response from the service will be ignored.
 	ShouldSendDeferred bool `json:"shouldSendDeferred,omitempty"`
 
-	ServiceName string `json:"serviceName"`
+	// ServiceName is the name of the service, or namespace/name for a service in another namespace.
+	// Services in other namespaces must be allowed by a ReferenceGrant in their namespace.
+	ServiceName string `json:"serviceName,omitempty"`
+	// Backends split traffic between multiple services by weight, and are used instead of ServiceName if set.
+	Backends []Backend `json:"backends,omitempty"`
+	// StickyBy assigns each user or guild to the same backend by hashing its ID, either "user" or "guild". Backends are picked randomly if unset.
+	StickyBy string `json:"stickyBy,omitempty"`
+	// GuildRoutes send interactions from specific guilds to a dedicated service, instead of ServiceName or Backends. The first matching route is used.
+	GuildRoutes []GuildRoute `json:"guildRoutes,omitempty"`
+	// External is an HTTPS endpoint outside the cluster, used instead of ServiceName or Backends if set.
+	External *ExternalBackend `json:"external,omitempty"`
+	// Port is the name or number of the service port to send interactions to.
+	// Defaults to the port named "http", otherwise the first port. Ports with an appProtocol of https are sent requests over TLS,
+	// verified with the CA bundle in the ConfigMap named by the Service's powergrid.sportshead.dev/ca-bundle annotation, or the system roots.
+	Port *intstr.IntOrString `json:"port,omitempty"`
+	// Path is the path interactions are sent to on the service, defaults to "/".
+	// {command} is replaced with the name of the command, e.g. /interactions/{command}
+	Path string `json:"path,omitempty"`
+	// Transport is how interactions are sent to the service, either "http", "grpc" or "grpc-stream".
+	// Defaults to "grpc" if the service port has an appProtocol of grpc, otherwise "http".
+	// With "grpc-stream", responses after the first are sent as follow up messages. See pkg/proto/interaction/v1.
+	Transport string `json:"transport,omitempty"`
+
 	// Command represents the Discord command object.
 	Command apiextensionsv1.JSON `json:"command"`
+
+	// Response is answered by the coordinator instead of forwarding the interaction, for commands which don't need a service.
+	// Access policies, entitlements, rate limits and audit still apply. Autocomplete interactions receive no choices.
+	Response *StaticResponse `json:"response,omitempty"`
+
+	// Permissions are the per-guild permission overwrites for the command. Guilds which are not listed are left untouched.
+	Permissions []GuildCommandPermissions `json:"permissions,omitempty"`
+
+	// RequiredSKUs are the IDs of SKUs which unlock the command. If set, the user or guild needs an entitlement to at least one of them.
+	RequiredSKUs []string `json:"requiredSKUs,omitempty"`
+
+	// RateLimits are token bucket limits on how often the command can be used. Autocomplete interactions are not limited.
+	RateLimits []RateLimit `json:"rateLimits,omitempty"`
+
+	// AccessPolicies are the names of AccessPolicy resources in the same namespace. Every policy must allow the interaction.
+	AccessPolicies []string `json:"accessPolicies,omitempty"`
+
+	// Audit records who used the command, with its option values and outcome, e.g. for moderation commands.
+	Audit *Audit `json:"audit,omitempty"`
+
+	// AutocompleteCache caches the service's responses to autocomplete interactions, by the focused option's name and value.
+	AutocompleteCache *AutocompleteCache `json:"autocompleteCache,omitempty"`
+}
+
+const (
+	RateLimitScopeUser    = "user"
+	RateLimitScopeGuild   = "guild"
+	RateLimitScopeChannel = "channel"
+)
+
+const (
+	TransportHTTP       = "http"
+	TransportGRPC       = "grpc"
+	TransportGRPCStream = "grpc-stream"
+)
+
+// RateLimit is a token bucket limit, shared by all replicas of the coordinator.
+type RateLimit struct {
+	// Scope is what the limit applies to, either "user", "guild" or "channel". Guild limits do not apply in DMs.
+	Scope string `json:"scope"`
+	// Limit is the size of the bucket, i.e. the number of uses allowed in a burst.
+	Limit int32 `json:"limit"`
+	// Period is the time taken for an empty bucket to refill completely.
+	Period metav1.Duration `json:"period"`
+}
+
+const (
+	StickyByUser  = "user"
+	StickyByGuild = "guild"
+)
+
+// Backend is a service which receives a share of a command's traffic.
+type Backend struct {
+	ServiceName string `json:"serviceName"`
+	// Weight is the share of traffic sent to the service, relative to the other backends.
+	Weight int32 `json:"weight"`
+}
+
+// ExternalBackend is an HTTPS endpoint outside the cluster, such as a serverless function.
+type ExternalBackend struct {
+	// URL is the https URL interactions are sent to as a POST request.
+	// Hosts inside the cluster, such as service DNS names and private addresses, are rejected, and must be referenced as a service instead.
+	URL string `json:"url"`
+	// Headers are added to every request, e.g. for authentication.
+	Headers []ExternalHeader `json:"headers,omitempty"`
+}
+
+// ExternalHeader is a header sent to an external backend, with a value which is either set directly or read from a Secret.
+type ExternalHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	// ValueFrom reads the value from a Secret in the same namespace.
+	ValueFrom *SecretKeyRef `json:"valueFrom,omitempty"`
+}
+
+// SecretKeyRef selects a key of a Secret in the same namespace.
+type SecretKeyRef struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// Audit records every use of a command to a set of sinks.
+type Audit struct {
+	// MaskedOptions are the names of options whose values are replaced in audit records, e.g. for tokens or personal data.
+	// Options of subcommands are matched by name.
+	MaskedOptions []string `json:"maskedOptions,omitempty"`
+	// Sinks are where audit records are sent. Each record is sent to every sink.
+	Sinks []AuditSink `json:"sinks"`
+}
+
+// StaticResponse is a message the coordinator responds with. Every string in it is a Go text/template,
+// rendered with the user, member, guild and channel of the interaction and the values of its options, e.g. "Hi {{.User.Username}}".
+// Mentions are shown, but don't notify anyone.
+type StaticResponse struct {
+	// Content is the text of the message.
+	Content string `json:"content,omitempty"`
+	// Embeds are Discord embed objects.
+	Embeds []apiextensionsv1.JSON `json:"embeds,omitempty"`
+	// Components are Discord component objects, i.e. action rows.
+	Components []apiextensionsv1.JSON `json:"components,omitempty"`
+	// Ephemeral messages are only shown to the user who used the command.
+	Ephemeral bool `json:"ephemeral,omitempty"`
+}
+
+const (
+	AutocompleteCacheVaryByUser    = "user"
+	AutocompleteCacheVaryByGuild   = "guild"
+	AutocompleteCacheVaryByLocale  = "locale"
+	AutocompleteCacheVaryByOptions = "options"
+)
+
+// AutocompleteCache caches autocomplete responses in the memory of each replica of the coordinator.
+type AutocompleteCache struct {
+	// TTL is how long responses are cached for. A Cache-Control header in the response takes precedence:
+	// s-maxage or max-age set how long it is cached for, and no-store or no-cache keep it from being cached.
+	TTL metav1.Duration `json:"ttl"`
+	// VaryBy adds to the key responses are cached by, from "user", "guild", "locale" and "options", the values of the other options.
+	// Responses with Cache-Control: private are only cached if they vary by user.
+	VaryBy []string `json:"varyBy,omitempty"`
+}
+
+// AuditSink is a destination for audit records. Exactly one field must be set.
+type AuditSink struct {
+	// File is the name of a file in the coordinator's audit directory, prefixed with the namespace and an underscore, which records are appended to as JSON lines.
+	File string `json:"file,omitempty"`
+	// DiscordWebhook reads the URL of a Discord webhook from a Secret in the same namespace, which records are posted to as messages.
+	DiscordWebhook *SecretKeyRef `json:"discordWebhook,omitempty"`
+	// HTTP is a collector which records are posted to as JSON.
+	HTTP *AuditHTTPSink `json:"http,omitempty"`
+}
+
+// AuditHTTPSink is an HTTP endpoint which receives audit records as JSON POST requests.
+type AuditHTTPSink struct {
+	// URL must be https, and can't be cluster-local.
+	URL string `json:"url"`
+	// Headers are added to every request, e.g. for authentication.
+	Headers []ExternalHeader `json:"headers,omitempty"`
+}
+
+// GuildRoute sends interactions from a set of guilds to a dedicated service.
+type GuildRoute struct {
+	// GuildIDs are the IDs of the guilds to route.
+	GuildIDs []string `json:"guildIDs,omitempty"`
+	// GuildIDsFrom reads more guild IDs from a ConfigMap in the same namespace, separated by whitespace or commas.
+	GuildIDsFrom *ConfigMapKeyRef `json:"guildIDsFrom,omitempty"`
+
+	ServiceName string `json:"serviceName"`
+}
+
+// ConfigMapKeyRef selects a key of a ConfigMap in the same namespace.
+type ConfigMapKeyRef struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// GuildCommandPermissions are the permission overwrites of a command in a single guild.
+type GuildCommandPermissions struct {
+	// GuildID is the ID of the guild the overwrites apply to.
+	GuildID string `json:"guildID"`
+	// Permissions are the overwrites for the guild. An empty list resets the command to its default permissions.
+	Permissions []CommandPermission `json:"permissions"`
+}
+
+// CommandPermission is a single command permission overwrite.
+// See https://discord.com/developers/docs/interactions/application-commands#application-command-permissions-object-application-command-permissions-structure
+type CommandPermission struct {
+	// ID is the ID of the role, user or channel. The guild ID can be used to target @everyone, and the guild ID minus one to target all channels.
+	ID string `json:"id"`
+	// Type is 1 for a role, 2 for a user or 3 for a channel.
+	Type int `json:"type"`
+	// Permission indicates whether the command is allowed or denied.
+	Permission bool `json:"permission"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RoleConnectionMetadataRecord is a RoleConnectionMetadataRecord resource.
+// Each record is a field which server admins can use as a linked role requirement.
+type RoleConnectionMetadataRecord struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec RoleConnectionMetadataRecordSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RoleConnectionMetadataRecordList is a collection of RoleConnectionMetadataRecord resources.
+type RoleConnectionMetadataRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RoleConnectionMetadataRecord `json:"items"`
+}
+
+// RoleConnectionMetadataRecordSpec is the spec of a RoleConnectionMetadataRecord resource.
+// See https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object
+type RoleConnectionMetadataRecordSpec struct {
+	// Type is the type of the metadata value, and how it is compared to the guild's configured value.
+	Type int `json:"type"`
+	// Key is the dictionary key for the metadata field, used by services when pushing user metadata.
+	Key string `json:"key"`
+	// Name is the name of the metadata field.
+	Name string `json:"name"`
+	// NameLocalizations are translations of the name, keyed by Discord locale.
+	NameLocalizations map[string]string `json:"nameLocalizations,omitempty"`
+	// Description is the description of the metadata field.
+	Description string `json:"description"`
+	// DescriptionLocalizations are translations of the description, keyed by Discord locale.
+	DescriptionLocalizations map[string]string `json:"descriptionLocalizations,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventSubscription is an EventSubscription resource.
+// It routes Discord webhook events to a service.
+type EventSubscription struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec EventSubscriptionSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventSubscriptionList is a collection of EventSubscription resources.
+type EventSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	Items []EventSubscription `json:"items"`
+}
+
+// EventSubscriptionSpec is the spec of an EventSubscription resource.
+type EventSubscriptionSpec struct {
+	// EventTypes are the webhook event types delivered to the service, such as APPLICATION_AUTHORIZED. If empty, all events are delivered.
+	// See https://discord.com/developers/docs/events/webhook-events#event-types
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	ServiceName string `json:"serviceName"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ComponentRoute is a ComponentRoute resource.
+// It routes message component and modal submit interactions to a service by the prefix of their custom_id.
+// Without a matching ComponentRoute, the prefix is used as the service name.
+type ComponentRoute struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec ComponentRouteSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ComponentRouteList is a collection of ComponentRoute resources.
+type ComponentRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ComponentRoute `json:"items"`
+}
+
+// ComponentRouteSpec is the spec of a ComponentRoute resource.
+type ComponentRouteSpec struct {
+	// Prefix is the part of the custom_id before the first "/".
+	Prefix string `json:"prefix"`
+
+	ServiceName string `json:"serviceName,omitempty"`
+	// GuildRoutes send interactions from specific guilds to a dedicated service, instead of ServiceName. The first matching route is used.
+	GuildRoutes []GuildRoute `json:"guildRoutes,omitempty"`
+	// External is an HTTPS endpoint outside the cluster, used instead of ServiceName if set.
+	External *ExternalBackend `json:"external,omitempty"`
+	// Port is the name or number of the service port to send interactions to, like CommandSpec.Port.
+	// Defaults to the port named "http", otherwise the first port.
+	Port *intstr.IntOrString `json:"port,omitempty"`
+	// Path is the path interactions are sent to on the service, defaults to "/".
+	// {prefix} is replaced with the prefix, e.g. /components/{prefix}
+	Path string `json:"path,omitempty"`
+
+	// RequiredSKUs are the IDs of SKUs which unlock the components. If set, the user or guild needs an entitlement to at least one of them.
+	RequiredSKUs []string `json:"requiredSKUs,omitempty"`
+
+	// AccessPolicies are the names of AccessPolicy resources in the same namespace. Every policy must allow the interaction.
+	AccessPolicies []string `json:"accessPolicies,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessPolicy is an AccessPolicy resource.
+// It restricts who can use the Commands and ComponentRoutes it is attached to.
+type AccessPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec AccessPolicySpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessPolicyList is a collection of AccessPolicy resources.
+type AccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AccessPolicy `json:"items"`
+}
+
+// AccessPolicySpec is the spec of an AccessPolicy resource.
+type AccessPolicySpec struct {
+	// Allow rules. If any are set, the interaction must match at least one of them.
+	Allow []AccessRule `json:"allow,omitempty"`
+	// Deny rules. An interaction matching any of them is denied, even if it is allowed.
+	Deny []AccessRule `json:"deny,omitempty"`
+}
+
+// AccessRule matches an interaction if all of its fields match. Empty fields match any interaction.
+// Lists match if they contain the interaction's value, or any of the member's roles.
+// At least one field must be set, and deny rules with invalid permissions match every interaction their other fields match.
+type AccessRule struct {
+	Users    []string `json:"users,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+	Guilds   []string `json:"guilds,omitempty"`
+	Channels []string `json:"channels,omitempty"`
+	// Permissions is a permission bitfield, serialized as a string. The member must have all of the permissions.
+	// See https://discord.com/developers/docs/topics/permissions#permissions-bitwise-permission-flags
+	Permissions string `json:"permissions,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReferenceGrant is a ReferenceGrant resource.
+// It allows resources in other namespaces to route interactions to services in its namespace.
+type ReferenceGrant struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec ReferenceGrantSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReferenceGrantList is a collection of ReferenceGrant resources.
+type ReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ReferenceGrant `json:"items"`
+}
+
+// ReferenceGrantSpec is the spec of a ReferenceGrant resource.
+type ReferenceGrantSpec struct {
+	// From are the namespaces which may reference services in this namespace.
+	From []ReferenceGrantFrom `json:"from"`
+	// To are the services which may be referenced. All services in the namespace may be referenced if empty.
+	To []ReferenceGrantTo `json:"to,omitempty"`
+}
+
+// ReferenceGrantFrom is a namespace which may reference services.
+type ReferenceGrantFrom struct {
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo is a service which may be referenced.
+type ReferenceGrantTo struct {
+	Name string `json:"name"`
 }
@@ -13,7 +13,8 @@ import (
 type CommandApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *CommandSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *CommandSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *CommandStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // Command constructs an declarative configuration of the Command type for use with
@@ -192,3 +193,11 @@ func (b *CommandApplyConfiguration) WithSpec(value *CommandSpecApplyConfiguratio
 	b.Spec = value
 	return b
 }
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *CommandApplyConfiguration) WithStatus(value *CommandStatusApplyConfiguration) *CommandApplyConfiguration {
+	b.Status = value
+	return b
+}
@@ -4,14 +4,29 @@ package v10
 
 import (
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // CommandSpecApplyConfiguration represents an declarative configuration of the CommandSpec type for use
 // with apply.
 type CommandSpecApplyConfiguration struct {
-	ShouldSendDeferred *bool    `json:"shouldSendDeferred,omitempty"`
-	ServiceName        *string  `json:"serviceName,omitempty"`
-	Command            *v1.JSON `json:"command,omitempty"`
+	ShouldSendDeferred *bool                                       `json:"shouldSendDeferred,omitempty"`
+	ServiceName        *string                                     `json:"serviceName,omitempty"`
+	Backends           []BackendApplyConfiguration                 `json:"backends,omitempty"`
+	StickyBy           *string                                     `json:"stickyBy,omitempty"`
+	GuildRoutes        []GuildRouteApplyConfiguration              `json:"guildRoutes,omitempty"`
+	External           *ExternalBackendApplyConfiguration          `json:"external,omitempty"`
+	Port               *intstr.IntOrString                         `json:"port,omitempty"`
+	Path               *string                                     `json:"path,omitempty"`
+	Transport          *string                                     `json:"transport,omitempty"`
+	Command            *v1.JSON                                    `json:"command,omitempty"`
+	Response           *StaticResponseApplyConfiguration           `json:"response,omitempty"`
+	Permissions        []GuildCommandPermissionsApplyConfiguration `json:"permissions,omitempty"`
+	RequiredSKUs       []string                                    `json:"requiredSKUs,omitempty"`
+	RateLimits         []RateLimitApplyConfiguration               `json:"rateLimits,omitempty"`
+	AccessPolicies     []string                                    `json:"accessPolicies,omitempty"`
+	Audit              *AuditApplyConfiguration                    `json:"audit,omitempty"`
+	AutocompleteCache  *AutocompleteCacheApplyConfiguration        `json:"autocompleteCache,omitempty"`
 }
 
 // CommandSpecApplyConfiguration constructs an declarative configuration of the CommandSpec type for use with
@@ -36,6 +51,72 @@ func (b *CommandSpecApplyConfiguration) WithServiceName(value string) *CommandSp
 	return b
 }
 
+// WithBackends adds the given value to the Backends field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Backends field.
+func (b *CommandSpecApplyConfiguration) WithBackends(values ...*BackendApplyConfiguration) *CommandSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBackends")
+		}
+		b.Backends = append(b.Backends, *values[i])
+	}
+	return b
+}
+
+// WithStickyBy sets the StickyBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StickyBy field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithStickyBy(value string) *CommandSpecApplyConfiguration {
+	b.StickyBy = &value
+	return b
+}
+
+// WithGuildRoutes adds the given value to the GuildRoutes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the GuildRoutes field.
+func (b *CommandSpecApplyConfiguration) WithGuildRoutes(values ...*GuildRouteApplyConfiguration) *CommandSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithGuildRoutes")
+		}
+		b.GuildRoutes = append(b.GuildRoutes, *values[i])
+	}
+	return b
+}
+
+// WithExternal sets the External field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the External field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithExternal(value *ExternalBackendApplyConfiguration) *CommandSpecApplyConfiguration {
+	b.External = value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithPort(value intstr.IntOrString) *CommandSpecApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithPath(value string) *CommandSpecApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithTransport sets the Transport field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Transport field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithTransport(value string) *CommandSpecApplyConfiguration {
+	b.Transport = &value
+	return b
+}
+
 // WithCommand sets the Command field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Command field is set to the value of the last call.
@@ -43,3 +124,73 @@ func (b *CommandSpecApplyConfiguration) WithCommand(value v1.JSON) *CommandSpecA
 	b.Command = &value
 	return b
 }
+
+// WithResponse sets the Response field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Response field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithResponse(value *StaticResponseApplyConfiguration) *CommandSpecApplyConfiguration {
+	b.Response = value
+	return b
+}
+
+// WithPermissions adds the given value to the Permissions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Permissions field.
+func (b *CommandSpecApplyConfiguration) WithPermissions(values ...*GuildCommandPermissionsApplyConfiguration) *CommandSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPermissions")
+		}
+		b.Permissions = append(b.Permissions, *values[i])
+	}
+	return b
+}
+
+// WithRequiredSKUs adds the given value to the RequiredSKUs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RequiredSKUs field.
+func (b *CommandSpecApplyConfiguration) WithRequiredSKUs(values ...string) *CommandSpecApplyConfiguration {
+	for i := range values {
+		b.RequiredSKUs = append(b.RequiredSKUs, values[i])
+	}
+	return b
+}
+
+// WithRateLimits adds the given value to the RateLimits field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RateLimits field.
+func (b *CommandSpecApplyConfiguration) WithRateLimits(values ...*RateLimitApplyConfiguration) *CommandSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRateLimits")
+		}
+		b.RateLimits = append(b.RateLimits, *values[i])
+	}
+	return b
+}
+
+// WithAccessPolicies adds the given value to the AccessPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AccessPolicies field.
+func (b *CommandSpecApplyConfiguration) WithAccessPolicies(values ...string) *CommandSpecApplyConfiguration {
+	for i := range values {
+		b.AccessPolicies = append(b.AccessPolicies, values[i])
+	}
+	return b
+}
+
+// WithAudit sets the Audit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Audit field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithAudit(value *AuditApplyConfiguration) *CommandSpecApplyConfiguration {
+	b.Audit = value
+	return b
+}
+
+// WithAutocompleteCache sets the AutocompleteCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutocompleteCache field is set to the value of the last call.
+func (b *CommandSpecApplyConfiguration) WithAutocompleteCache(value *AutocompleteCacheApplyConfiguration) *CommandSpecApplyConfiguration {
+	b.AutocompleteCache = value
+	return b
+}
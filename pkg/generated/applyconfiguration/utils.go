@@ -13,10 +13,66 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=powergrid.sportshead.dev, Version=v10
+	case v10.SchemeGroupVersion.WithKind("AccessPolicy"):
+		return &powergridsportsheaddevv10.AccessPolicyApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("AccessPolicySpec"):
+		return &powergridsportsheaddevv10.AccessPolicySpecApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("AccessRule"):
+		return &powergridsportsheaddevv10.AccessRuleApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("Audit"):
+		return &powergridsportsheaddevv10.AuditApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("AuditHTTPSink"):
+		return &powergridsportsheaddevv10.AuditHTTPSinkApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("AuditSink"):
+		return &powergridsportsheaddevv10.AuditSinkApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("AutocompleteCache"):
+		return &powergridsportsheaddevv10.AutocompleteCacheApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("Backend"):
+		return &powergridsportsheaddevv10.BackendApplyConfiguration{}
 	case v10.SchemeGroupVersion.WithKind("Command"):
 		return &powergridsportsheaddevv10.CommandApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("CommandPermission"):
+		return &powergridsportsheaddevv10.CommandPermissionApplyConfiguration{}
 	case v10.SchemeGroupVersion.WithKind("CommandSpec"):
 		return &powergridsportsheaddevv10.CommandSpecApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("CommandStatus"):
+		return &powergridsportsheaddevv10.CommandStatusApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ComponentRoute"):
+		return &powergridsportsheaddevv10.ComponentRouteApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ComponentRouteSpec"):
+		return &powergridsportsheaddevv10.ComponentRouteSpecApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ConfigMapKeyRef"):
+		return &powergridsportsheaddevv10.ConfigMapKeyRefApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("EventSubscription"):
+		return &powergridsportsheaddevv10.EventSubscriptionApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("EventSubscriptionSpec"):
+		return &powergridsportsheaddevv10.EventSubscriptionSpecApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ExternalBackend"):
+		return &powergridsportsheaddevv10.ExternalBackendApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ExternalHeader"):
+		return &powergridsportsheaddevv10.ExternalHeaderApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("GuildCommandPermissions"):
+		return &powergridsportsheaddevv10.GuildCommandPermissionsApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("GuildRoute"):
+		return &powergridsportsheaddevv10.GuildRouteApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("RateLimit"):
+		return &powergridsportsheaddevv10.RateLimitApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ReferenceGrant"):
+		return &powergridsportsheaddevv10.ReferenceGrantApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ReferenceGrantFrom"):
+		return &powergridsportsheaddevv10.ReferenceGrantFromApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ReferenceGrantSpec"):
+		return &powergridsportsheaddevv10.ReferenceGrantSpecApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("ReferenceGrantTo"):
+		return &powergridsportsheaddevv10.ReferenceGrantToApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("RoleConnectionMetadataRecord"):
+		return &powergridsportsheaddevv10.RoleConnectionMetadataRecordApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("RoleConnectionMetadataRecordSpec"):
+		return &powergridsportsheaddevv10.RoleConnectionMetadataRecordSpecApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("SecretKeyRef"):
+		return &powergridsportsheaddevv10.SecretKeyRefApplyConfiguration{}
+	case v10.SchemeGroupVersion.WithKind("StaticResponse"):
+		return &powergridsportsheaddevv10.StaticResponseApplyConfiguration{}
 
 	}
 	return nil
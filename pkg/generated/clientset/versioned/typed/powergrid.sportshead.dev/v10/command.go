@@ -27,6 +27,7 @@ type CommandsGetter interface {
 type CommandInterface interface {
 	Create(ctx context.Context, command *v10.Command, opts v1.CreateOptions) (*v10.Command, error)
 	Update(ctx context.Context, command *v10.Command, opts v1.UpdateOptions) (*v10.Command, error)
+	UpdateStatus(ctx context.Context, command *v10.Command, opts v1.UpdateOptions) (*v10.Command, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v10.Command, error)
@@ -34,6 +35,7 @@ type CommandInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v10.Command, err error)
 	Apply(ctx context.Context, command *powergridsportsheaddevv10.CommandApplyConfiguration, opts v1.ApplyOptions) (result *v10.Command, err error)
+	ApplyStatus(ctx context.Context, command *powergridsportsheaddevv10.CommandApplyConfiguration, opts v1.ApplyOptions) (result *v10.Command, err error)
 	CommandExpansion
 }
 
@@ -123,6 +125,22 @@ func (c *commands) Update(ctx context.Context, command *v10.Command, opts v1.Upd
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *commands) UpdateStatus(ctx context.Context, command *v10.Command, opts v1.UpdateOptions) (result *v10.Command, err error) {
+	result = &v10.Command{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("commands").
+		Name(command.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(command).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the command and deletes it. Returns an error if one occurs.
 func (c *commands) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -190,3 +208,33 @@ func (c *commands) Apply(ctx context.Context, command *powergridsportsheaddevv10
 		Into(result)
 	return
 }
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *commands) ApplyStatus(ctx context.Context, command *powergridsportsheaddevv10.CommandApplyConfiguration, opts v1.ApplyOptions) (result *v10.Command, err error) {
+	if command == nil {
+		return nil, fmt.Errorf("command provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(command)
+	if err != nil {
+		return nil, err
+	}
+
+	name := command.Name
+	if name == nil {
+		return nil, fmt.Errorf("command.Name must be provided to Apply")
+	}
+
+	result = &v10.Command{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("commands").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
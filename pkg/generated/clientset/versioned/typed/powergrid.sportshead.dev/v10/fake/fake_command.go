@@ -88,6 +88,18 @@ func (c *FakeCommands) Update(ctx context.Context, command *v10.Command, opts v1
 	return obj.(*v10.Command), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCommands) UpdateStatus(ctx context.Context, command *v10.Command, opts v1.UpdateOptions) (*v10.Command, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(commandsResource, "status", c.ns, command), &v10.Command{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v10.Command), err
+}
+
 // Delete takes name of the command and deletes it. Returns an error if one occurs.
 func (c *FakeCommands) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -136,3 +148,26 @@ func (c *FakeCommands) Apply(ctx context.Context, command *powergridsportsheadde
 	}
 	return obj.(*v10.Command), err
 }
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeCommands) ApplyStatus(ctx context.Context, command *powergridsportsheaddevv10.CommandApplyConfiguration, opts v1.ApplyOptions) (result *v10.Command, err error) {
+	if command == nil {
+		return nil, fmt.Errorf("command provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(command)
+	if err != nil {
+		return nil, err
+	}
+	name := command.Name
+	if name == nil {
+		return nil, fmt.Errorf("command.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(commandsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v10.Command{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v10.Command), err
+}
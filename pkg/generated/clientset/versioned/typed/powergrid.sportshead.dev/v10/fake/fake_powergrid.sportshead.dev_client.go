@@ -12,10 +12,30 @@ type FakePowergridV10 struct {
 	*testing.Fake
 }
 
+func (c *FakePowergridV10) AccessPolicies(namespace string) v10.AccessPolicyInterface {
+	return &FakeAccessPolicies{c, namespace}
+}
+
 func (c *FakePowergridV10) Commands(namespace string) v10.CommandInterface {
 	return &FakeCommands{c, namespace}
 }
 
+func (c *FakePowergridV10) ComponentRoutes(namespace string) v10.ComponentRouteInterface {
+	return &FakeComponentRoutes{c, namespace}
+}
+
+func (c *FakePowergridV10) EventSubscriptions(namespace string) v10.EventSubscriptionInterface {
+	return &FakeEventSubscriptions{c, namespace}
+}
+
+func (c *FakePowergridV10) ReferenceGrants(namespace string) v10.ReferenceGrantInterface {
+	return &FakeReferenceGrants{c, namespace}
+}
+
+func (c *FakePowergridV10) RoleConnectionMetadataRecords(namespace string) v10.RoleConnectionMetadataRecordInterface {
+	return &FakeRoleConnectionMetadataRecords{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakePowergridV10) RESTClient() rest.Interface {
@@ -2,4 +2,14 @@
 
 package v10
 
+type AccessPolicyExpansion interface{}
+
 type CommandExpansion interface{}
+
+type ComponentRouteExpansion interface{}
+
+type EventSubscriptionExpansion interface{}
+
+type ReferenceGrantExpansion interface{}
+
+type RoleConnectionMetadataRecordExpansion interface{}
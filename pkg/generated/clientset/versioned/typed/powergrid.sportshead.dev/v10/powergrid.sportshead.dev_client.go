@@ -12,7 +12,12 @@ import (
 
 type PowergridV10Interface interface {
 	RESTClient() rest.Interface
+	AccessPoliciesGetter
 	CommandsGetter
+	ComponentRoutesGetter
+	EventSubscriptionsGetter
+	ReferenceGrantsGetter
+	RoleConnectionMetadataRecordsGetter
 }
 
 // PowergridV10Client is used to interact with features provided by the powergrid.sportshead.dev group.
@@ -20,10 +25,30 @@ type PowergridV10Client struct {
 	restClient rest.Interface
 }
 
+func (c *PowergridV10Client) AccessPolicies(namespace string) AccessPolicyInterface {
+	return newAccessPolicies(c, namespace)
+}
+
 func (c *PowergridV10Client) Commands(namespace string) CommandInterface {
 	return newCommands(c, namespace)
 }
 
+func (c *PowergridV10Client) ComponentRoutes(namespace string) ComponentRouteInterface {
+	return newComponentRoutes(c, namespace)
+}
+
+func (c *PowergridV10Client) EventSubscriptions(namespace string) EventSubscriptionInterface {
+	return newEventSubscriptions(c, namespace)
+}
+
+func (c *PowergridV10Client) ReferenceGrants(namespace string) ReferenceGrantInterface {
+	return newReferenceGrants(c, namespace)
+}
+
+func (c *PowergridV10Client) RoleConnectionMetadataRecords(namespace string) RoleConnectionMetadataRecordInterface {
+	return newRoleConnectionMetadataRecords(c, namespace)
+}
+
 // NewForConfig creates a new PowergridV10Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -37,8 +37,18 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=powergrid.sportshead.dev, Version=v10
+	case v10.SchemeGroupVersion.WithResource("accesspolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Powergrid().V10().AccessPolicies().Informer()}, nil
 	case v10.SchemeGroupVersion.WithResource("commands"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Powergrid().V10().Commands().Informer()}, nil
+	case v10.SchemeGroupVersion.WithResource("componentroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Powergrid().V10().ComponentRoutes().Informer()}, nil
+	case v10.SchemeGroupVersion.WithResource("eventsubscriptions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Powergrid().V10().EventSubscriptions().Informer()}, nil
+	case v10.SchemeGroupVersion.WithResource("referencegrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Powergrid().V10().ReferenceGrants().Informer()}, nil
+	case v10.SchemeGroupVersion.WithResource("roleconnectionmetadatarecords"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Powergrid().V10().RoleConnectionMetadataRecords().Informer()}, nil
 
 	}
 
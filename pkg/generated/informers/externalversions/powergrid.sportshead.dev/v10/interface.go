@@ -8,8 +8,18 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AccessPolicies returns a AccessPolicyInformer.
+	AccessPolicies() AccessPolicyInformer
 	// Commands returns a CommandInformer.
 	Commands() CommandInformer
+	// ComponentRoutes returns a ComponentRouteInformer.
+	ComponentRoutes() ComponentRouteInformer
+	// EventSubscriptions returns a EventSubscriptionInformer.
+	EventSubscriptions() EventSubscriptionInformer
+	// ReferenceGrants returns a ReferenceGrantInformer.
+	ReferenceGrants() ReferenceGrantInformer
+	// RoleConnectionMetadataRecords returns a RoleConnectionMetadataRecordInformer.
+	RoleConnectionMetadataRecords() RoleConnectionMetadataRecordInformer
 }
 
 type version struct {
@@ -23,7 +33,32 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AccessPolicies returns a AccessPolicyInformer.
+func (v *version) AccessPolicies() AccessPolicyInformer {
+	return &accessPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Commands returns a CommandInformer.
 func (v *version) Commands() CommandInformer {
 	return &commandInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ComponentRoutes returns a ComponentRouteInformer.
+func (v *version) ComponentRoutes() ComponentRouteInformer {
+	return &componentRouteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// EventSubscriptions returns a EventSubscriptionInformer.
+func (v *version) EventSubscriptions() EventSubscriptionInformer {
+	return &eventSubscriptionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ReferenceGrants returns a ReferenceGrantInformer.
+func (v *version) ReferenceGrants() ReferenceGrantInformer {
+	return &referenceGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RoleConnectionMetadataRecords returns a RoleConnectionMetadataRecordInformer.
+func (v *version) RoleConnectionMetadataRecords() RoleConnectionMetadataRecordInformer {
+	return &roleConnectionMetadataRecordInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
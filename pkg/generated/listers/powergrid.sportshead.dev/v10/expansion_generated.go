@@ -2,6 +2,14 @@
 
 package v10
 
+// AccessPolicyListerExpansion allows custom methods to be added to
+// AccessPolicyLister.
+type AccessPolicyListerExpansion interface{}
+
+// AccessPolicyNamespaceListerExpansion allows custom methods to be added to
+// AccessPolicyNamespaceLister.
+type AccessPolicyNamespaceListerExpansion interface{}
+
 // CommandListerExpansion allows custom methods to be added to
 // CommandLister.
 type CommandListerExpansion interface{}
@@ -9,3 +17,35 @@ type CommandListerExpansion interface{}
 // CommandNamespaceListerExpansion allows custom methods to be added to
 // CommandNamespaceLister.
 type CommandNamespaceListerExpansion interface{}
+
+// ComponentRouteListerExpansion allows custom methods to be added to
+// ComponentRouteLister.
+type ComponentRouteListerExpansion interface{}
+
+// ComponentRouteNamespaceListerExpansion allows custom methods to be added to
+// ComponentRouteNamespaceLister.
+type ComponentRouteNamespaceListerExpansion interface{}
+
+// EventSubscriptionListerExpansion allows custom methods to be added to
+// EventSubscriptionLister.
+type EventSubscriptionListerExpansion interface{}
+
+// EventSubscriptionNamespaceListerExpansion allows custom methods to be added to
+// EventSubscriptionNamespaceLister.
+type EventSubscriptionNamespaceListerExpansion interface{}
+
+// ReferenceGrantListerExpansion allows custom methods to be added to
+// ReferenceGrantLister.
+type ReferenceGrantListerExpansion interface{}
+
+// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
+// ReferenceGrantNamespaceLister.
+type ReferenceGrantNamespaceListerExpansion interface{}
+
+// RoleConnectionMetadataRecordListerExpansion allows custom methods to be added to
+// RoleConnectionMetadataRecordLister.
+type RoleConnectionMetadataRecordListerExpansion interface{}
+
+// RoleConnectionMetadataRecordNamespaceListerExpansion allows custom methods to be added to
+// RoleConnectionMetadataRecordNamespaceLister.
+type RoleConnectionMetadataRecordNamespaceListerExpansion interface{}
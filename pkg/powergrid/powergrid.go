@@ -0,0 +1,47 @@
+// Package powergrid contains the conventions shared by the coordinator and the services it forwards interactions to.
+// Services written in Go can use pkg/sdk, which is built on them.
+package powergrid
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"strings"
+)
+
+const (
+	// HeaderDeferred is set to "true" on interactions which the coordinator has already answered with a deferred response.
+	// The service's response is ignored, so it must edit the original response instead, e.g. through WebhookPath.
+	HeaderDeferred = "X-Powergrid-Deferred"
+
+	// WebhookPath is the internal API path prefix which relays interaction webhook requests to Discord, so services don't need the application ID.
+	// It is followed by the interaction token, and either nothing to create a follow up message, or messages/{id} to edit or delete one.
+	// The ID @original is the original response. Requests and responses are as documented by Discord.
+	// See https://discord.com/developers/docs/interactions/receiving-and-responding#followup-messages
+	WebhookPath = "/webhooks/"
+
+	// CustomIDSeparator separates the parts of a custom_id. The first part is the prefix of a ComponentRoute, or the name of a service.
+	CustomIDSeparator = "/"
+)
+
+// ComponentPrefix returns the part of a custom_id before the first separator, which the coordinator routes by.
+func ComponentPrefix(customID string) string {
+	prefix, _, _ := strings.Cut(customID, CustomIDSeparator)
+	return prefix
+}
+
+// CustomID joins the prefix and parts into a custom_id, e.g. CustomID("tickets", "close", id).
+func CustomID(prefix string, parts ...string) string {
+	return strings.Join(append([]string{prefix}, parts...), CustomIDSeparator)
+}
+
+// FullCommand returns the name of the command followed by its subcommand group and subcommand if any, e.g. ["mod", "ban"],
+// and the options of the innermost subcommand.
+func FullCommand(data discordgo.ApplicationCommandInteractionData) ([]string, []*discordgo.ApplicationCommandInteractionDataOption) {
+	name := []string{data.Name}
+	options := data.Options
+	// subcommand groups and subcommands are the only option at their level
+	for len(options) == 1 && (options[0].Type == discordgo.ApplicationCommandOptionSubCommandGroup || options[0].Type == discordgo.ApplicationCommandOptionSubCommand) {
+		name = append(name, options[0].Name)
+		options = options[0].Options
+	}
+	return name, options
+}
@@ -0,0 +1,214 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// optionTypes maps the Go types of option fields, as printed by reflect, to option types.
+// Fields with a pointer to a scalar type are left nil if the option is not set.
+var optionTypes = map[string]discordgo.ApplicationCommandOptionType{
+	"string":                       discordgo.ApplicationCommandOptionString,
+	"bool":                         discordgo.ApplicationCommandOptionBoolean,
+	"int":                          discordgo.ApplicationCommandOptionInteger,
+	"int8":                         discordgo.ApplicationCommandOptionInteger,
+	"int16":                        discordgo.ApplicationCommandOptionInteger,
+	"int32":                        discordgo.ApplicationCommandOptionInteger,
+	"int64":                        discordgo.ApplicationCommandOptionInteger,
+	"uint":                         discordgo.ApplicationCommandOptionInteger,
+	"uint8":                        discordgo.ApplicationCommandOptionInteger,
+	"uint16":                       discordgo.ApplicationCommandOptionInteger,
+	"uint32":                       discordgo.ApplicationCommandOptionInteger,
+	"uint64":                       discordgo.ApplicationCommandOptionInteger,
+	"float32":                      discordgo.ApplicationCommandOptionNumber,
+	"float64":                      discordgo.ApplicationCommandOptionNumber,
+	"*discordgo.User":              discordgo.ApplicationCommandOptionUser,
+	"*discordgo.Member":            discordgo.ApplicationCommandOptionUser,
+	"*discordgo.Channel":           discordgo.ApplicationCommandOptionChannel,
+	"*discordgo.Role":              discordgo.ApplicationCommandOptionRole,
+	"*discordgo.MessageAttachment": discordgo.ApplicationCommandOptionAttachment,
+}
+
+// OptionType returns the option type of a field's Go type, as printed by reflect, e.g. "int64" or "*discordgo.User".
+// String fields can also hold the ID of a user, channel, role or mentionable, if their type is set explicitly.
+func OptionType(goType string) (discordgo.ApplicationCommandOptionType, bool) {
+	if t, ok := optionTypes[goType]; ok {
+		return t, true
+	}
+	if scalar, ok := strings.CutPrefix(goType, "*"); ok && !strings.Contains(scalar, ".") {
+		t, ok := optionTypes[scalar]
+		return t, ok
+	}
+	return 0, false
+}
+
+// OptionName returns the option name of a struct field, which is set by a powergrid tag, or the field's name in snake case.
+// Returns an empty string for fields tagged powergrid:"-".
+func OptionName(fieldName string, tag reflect.StructTag) string {
+	name := tag.Get("powergrid")
+	if name == "-" {
+		return ""
+	}
+	if name != "" {
+		return name
+	}
+
+	var b strings.Builder
+	runes := []rune(fieldName)
+	for i, r := range runes {
+		// e.g. DeleteDays is delete_days, and UserID is user_id
+		if i > 0 && unicode.IsUpper(r) && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// Bind sets the fields of the struct v points to from the options of the command, named by OptionName.
+// Fields of options which aren't set are left as is. Users, channels, roles and attachments are read from the resolved data.
+// During autocomplete, numeric fields are also left as is while the focused option doesn't parse or fit, e.g. "" or "-".
+// Otherwise, numbers which aren't finite or don't fit in their field are an error, and the field is left as is.
+// The same struct can be annotated to generate the Command manifest, see pkg/sdk/gen.
+func (i *Interaction) Bind(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("sdk: Bind needs a pointer to a struct")
+	}
+	rv = rv.Elem()
+
+	resolved := &discordgo.ApplicationCommandInteractionDataResolved{}
+	if i.Type == discordgo.InteractionApplicationCommand || i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		if data := i.ApplicationCommandData(); data.Resolved != nil {
+			resolved = data.Resolved
+		}
+	}
+
+	t := rv.Type()
+	for n := 0; n < t.NumField(); n++ {
+		field := t.Field(n)
+		name := OptionName(field.Name, field.Tag)
+		if !field.IsExported() || name == "" {
+			continue
+		}
+		option, ok := i.Options[name]
+		if !ok {
+			continue
+		}
+		err := bindOption(rv.Field(n), option, resolved, i.Type == discordgo.InteractionApplicationCommandAutocomplete)
+		if err != nil {
+			return fmt.Errorf("sdk: option %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func bindOption(field reflect.Value, option *discordgo.ApplicationCommandInteractionDataOption, resolved *discordgo.ApplicationCommandInteractionDataResolved, autocomplete bool) error {
+	id, _ := option.Value.(string)
+	switch field.Interface().(type) {
+	case *discordgo.User:
+		field.Set(reflect.ValueOf(resolved.Users[id]))
+		return nil
+	case *discordgo.Member:
+		member := resolved.Members[id]
+		if member != nil && member.User == nil {
+			// members are resolved without their user
+			m := *member
+			m.User = resolved.Users[id]
+			member = &m
+		}
+		field.Set(reflect.ValueOf(member))
+		return nil
+	case *discordgo.Channel:
+		field.Set(reflect.ValueOf(resolved.Channels[id]))
+		return nil
+	case *discordgo.Role:
+		field.Set(reflect.ValueOf(resolved.Roles[id]))
+		return nil
+	case *discordgo.MessageAttachment:
+		field.Set(reflect.ValueOf(resolved.Attachments[id]))
+		return nil
+	}
+
+	// autocomplete sends what the user has typed so far as a string
+	t := field.Type()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if s, ok := option.Value.(string); ok && autocomplete && t.Kind() != reflect.String {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil
+		}
+		option = &discordgo.ApplicationCommandInteractionDataOption{Value: f}
+	}
+
+	value, err := optionValue(t, option.Value)
+	if errors.Is(err, errOutOfRange) && autocomplete {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the field is only set once the value is known to fit, so it is left as is on errors
+	if field.Kind() == reflect.Pointer {
+		pointer := reflect.New(t)
+		pointer.Elem().Set(value)
+		value = pointer
+	}
+	field.Set(value)
+	return nil
+}
+
+var errOutOfRange = errors.New("value out of range")
+
+// optionValue converts an option value to a value of type t. Numbers must be finite and fit in t, and are truncated for integer types.
+func optionValue(t reflect.Type, v interface{}) (reflect.Value, error) {
+	value := reflect.New(t).Elem()
+	var ok bool
+	switch t.Kind() {
+	case reflect.String:
+		var s string
+		s, ok = v.(string)
+		value.SetString(s)
+	case reflect.Bool:
+		var b bool
+		b, ok = v.(bool)
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var f float64
+		f, ok = v.(float64)
+		// NaN fails every comparison, and float64(math.MaxInt64) rounds up to 2^63
+		if ok && (!(f >= math.MinInt64 && f < math.MaxInt64) || value.OverflowInt(int64(f))) {
+			return value, fmt.Errorf("%w: %v for %s", errOutOfRange, f, t)
+		}
+		value.SetInt(int64(f))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var f float64
+		f, ok = v.(float64)
+		if ok && (!(f >= 0 && f < math.MaxUint64) || value.OverflowUint(uint64(f))) {
+			return value, fmt.Errorf("%w: %v for %s", errOutOfRange, f, t)
+		}
+		value.SetUint(uint64(f))
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, ok = v.(float64)
+		if ok && (math.IsNaN(f) || math.IsInf(f, 0) || value.OverflowFloat(f)) {
+			return value, fmt.Errorf("%w: %v for %s", errOutOfRange, f, t)
+		}
+		value.SetFloat(f)
+	default:
+		return value, fmt.Errorf("unsupported field type %s", t)
+	}
+	if !ok {
+		return value, fmt.Errorf("cannot set %s field from %T", t, v)
+	}
+	return value, nil
+}
@@ -0,0 +1,216 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"math"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestOptionName(t *testing.T) {
+	tests := []struct {
+		field string
+		tag   reflect.StructTag
+		want  string
+	}{
+		{field: "Reason", want: "reason"},
+		{field: "DeleteDays", want: "delete_days"},
+		{field: "UserID", want: "user_id"},
+		{field: "IDValue", want: "id_value"},
+		{field: "HTTPURL", want: "httpurl"},
+		{field: "A", want: "a"},
+		{field: "DeleteDays", tag: `powergrid:"days"`, want: "days"},
+		{field: "Ignored", tag: `powergrid:"-"`, want: ""},
+		{field: "Other", tag: `json:"other_name"`, want: "other"},
+	}
+	for _, test := range tests {
+		got := OptionName(test.field, test.tag)
+		if got != test.want {
+			t.Errorf("OptionName(%q, %q) = %q, want %q", test.field, test.tag, got, test.want)
+		}
+	}
+}
+
+type bindOptions struct {
+	Reason     string
+	Count      int64
+	Small      int8
+	Days       uint
+	Ratio      *float64
+	Limit      *int
+	Silent     bool
+	User       *discordgo.User
+	Member     *discordgo.Member
+	Channel    *discordgo.Channel
+	unexported string
+	Ignored    string `powergrid:"-"`
+}
+
+// newTestInteraction builds an interaction as newInteraction does, from the options and resolved data of an application command.
+func newTestInteraction(t *testing.T, interactionType discordgo.InteractionType, options string, resolved string) *Interaction {
+	t.Helper()
+	if resolved == "" {
+		resolved = "null"
+	}
+	body := `{"id":"1","type":` + string(mustMarshal(t, interactionType)) + `,"data":{"id":"2","name":"test","type":1,"options":` + options + `,"resolved":` + resolved + `}}`
+	i, err := newInteraction(NewHandler(), httptest.NewRequest("POST", "/", nil), []byte(body))
+	if err != nil {
+		t.Fatalf("failed to parse interaction: %v", err)
+	}
+	return i
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func float64Pointer(f float64) *float64 {
+	return &f
+}
+
+func intPointer(i int) *int {
+	return &i
+}
+
+func TestBind(t *testing.T) {
+	user := &discordgo.User{ID: "10", Username: "user"}
+	member := &discordgo.Member{Nick: "nick", User: user}
+	channel := &discordgo.Channel{ID: "20", Name: "general"}
+	resolved := `{"users":{"10":{"id":"10","username":"user"}},"members":{"10":{"nick":"nick"}},"channels":{"20":{"id":"20","name":"general"}}}`
+
+	tests := []struct {
+		name     string
+		typ      discordgo.InteractionType
+		options  string
+		resolved string
+		initial  bindOptions
+		want     bindOptions
+		wantErr  bool
+	}{
+		{
+			name:    "scalars",
+			typ:     discordgo.InteractionApplicationCommand,
+			options: `[{"name":"reason","type":3,"value":"spam"},{"name":"count","type":4,"value":3},{"name":"small","type":4,"value":-128},{"name":"days","type":4,"value":7},{"name":"silent","type":5,"value":true}]`,
+			want:    bindOptions{Reason: "spam", Count: 3, Small: -128, Days: 7, Silent: true},
+		},
+		{
+			name:    "pointers",
+			typ:     discordgo.InteractionApplicationCommand,
+			options: `[{"name":"ratio","type":10,"value":0.5},{"name":"limit","type":4,"value":2}]`,
+			want:    bindOptions{Ratio: float64Pointer(0.5), Limit: intPointer(2)},
+		},
+		{
+			name:     "resolved",
+			typ:      discordgo.InteractionApplicationCommand,
+			options:  `[{"name":"user","type":6,"value":"10"},{"name":"member","type":6,"value":"10"},{"name":"channel","type":7,"value":"20"}]`,
+			resolved: resolved,
+			want:     bindOptions{User: user, Member: member, Channel: channel},
+		},
+		{
+			name:    "unset options are left as is",
+			typ:     discordgo.InteractionApplicationCommand,
+			options: `[{"name":"count","type":4,"value":1}]`,
+			initial: bindOptions{Reason: "default", Count: 5, unexported: "kept", Ignored: "kept"},
+			want:    bindOptions{Reason: "default", Count: 1, unexported: "kept", Ignored: "kept"},
+		},
+		{
+			name:    "type mismatch",
+			typ:     discordgo.InteractionApplicationCommand,
+			options: `[{"name":"limit","type":3,"value":"2"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "out of range",
+			typ:     discordgo.InteractionApplicationCommand,
+			options: `[{"name":"small","type":4,"value":128}]`,
+			initial: bindOptions{Small: 1},
+			want:    bindOptions{Small: 1},
+			wantErr: true,
+		},
+		{
+			name:    "negative unsigned",
+			typ:     discordgo.InteractionApplicationCommand,
+			options: `[{"name":"days","type":4,"value":-1}]`,
+			wantErr: true,
+		},
+		{
+			name:    "autocomplete parses numbers",
+			typ:     discordgo.InteractionApplicationCommandAutocomplete,
+			options: `[{"name":"count","type":4,"value":"12","focused":true},{"name":"ratio","type":10,"value":"0.25"}]`,
+			want:    bindOptions{Count: 12, Ratio: float64Pointer(0.25)},
+		},
+		{
+			name:    "autocomplete leaves partial numbers unset",
+			typ:     discordgo.InteractionApplicationCommandAutocomplete,
+			options: `[{"name":"count","type":4,"value":"-","focused":true},{"name":"limit","type":4,"value":""}]`,
+			initial: bindOptions{Count: 5},
+			want:    bindOptions{Count: 5},
+		},
+		{
+			name:    "autocomplete leaves non-finite and out of range numbers unset",
+			typ:     discordgo.InteractionApplicationCommandAutocomplete,
+			options: `[{"name":"ratio","type":10,"value":"NaN","focused":true},{"name":"limit","type":4,"value":"Inf"},{"name":"days","type":4,"value":"-3"}]`,
+			initial: bindOptions{Days: 2},
+			want:    bindOptions{Days: 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i := newTestInteraction(t, test.typ, test.options, test.resolved)
+			got := test.initial
+			err := i.Bind(&got)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Bind() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr && test.want == (bindOptions{}) {
+				test.want = test.initial
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Bind() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBindNonStruct(t *testing.T) {
+	i := newTestInteraction(t, discordgo.InteractionApplicationCommand, `[]`, "")
+	var s string
+	if err := i.Bind(&s); err == nil {
+		t.Error("Bind() of a string pointer succeeded")
+	}
+	if err := i.Bind(bindOptions{}); err == nil {
+		t.Error("Bind() of a struct value succeeded")
+	}
+}
+
+func TestOptionValueRange(t *testing.T) {
+	tests := []struct {
+		typ   reflect.Type
+		value float64
+	}{
+		{typ: reflect.TypeOf(int64(0)), value: math.NaN()},
+		{typ: reflect.TypeOf(int64(0)), value: math.Inf(1)},
+		{typ: reflect.TypeOf(int64(0)), value: math.MaxInt64},
+		{typ: reflect.TypeOf(int32(0)), value: math.MinInt32 - 1},
+		{typ: reflect.TypeOf(uint(0)), value: -1},
+		{typ: reflect.TypeOf(uint64(0)), value: math.Inf(1)},
+		{typ: reflect.TypeOf(uint8(0)), value: 256},
+		{typ: reflect.TypeOf(float64(0)), value: math.NaN()},
+		{typ: reflect.TypeOf(float64(0)), value: math.Inf(-1)},
+		{typ: reflect.TypeOf(float32(0)), value: math.MaxFloat64},
+	}
+	for _, test := range tests {
+		_, err := optionValue(test.typ, test.value)
+		if !errors.Is(err, errOutOfRange) {
+			t.Errorf("optionValue(%s, %v) error = %v, want %v", test.typ, test.value, err, errOutOfRange)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrNoCoordinator is returned when sending follow up messages without Handler.CoordinatorURL.
+var ErrNoCoordinator = errors.New("coordinator url is not set")
+
+// Followup sends a follow up message to the interaction through the coordinator.
+func (i *Interaction) Followup(ctx context.Context, params *discordgo.WebhookParams) (*discordgo.Message, error) {
+	message := &discordgo.Message{}
+	err := i.webhook(ctx, http.MethodPost, "", params, message)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// EditResponse edits the original response to the interaction through the coordinator, e.g. to replace a deferred response.
+func (i *Interaction) EditResponse(ctx context.Context, edit *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	return i.EditFollowup(ctx, "@original", edit)
+}
+
+// EditFollowup edits a follow up message, or the original response if the ID is @original.
+func (i *Interaction) EditFollowup(ctx context.Context, messageID string, edit *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	message := &discordgo.Message{}
+	err := i.webhook(ctx, http.MethodPatch, "messages/"+messageID, edit, message)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// DeleteFollowup deletes a follow up message, or the original response if the ID is @original.
+func (i *Interaction) DeleteFollowup(ctx context.Context, messageID string) error {
+	return i.webhook(ctx, http.MethodDelete, "messages/"+messageID, nil, nil)
+}
+
+// webhook sends a request to the coordinator's webhook relay, decoding the response into out if it is not nil.
+func (i *Interaction) webhook(ctx context.Context, method string, path string, in interface{}, out interface{}) error {
+	if i.handler.CoordinatorURL == "" {
+		return ErrNoCoordinator
+	}
+	url := strings.TrimSuffix(i.handler.CoordinatorURL, "/") + powergrid.WebhookPath + i.Token
+	if path != "" {
+		url += "/" + path
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", utils.MimeTypeJSON)
+	}
+
+	client := i.handler.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return fmt.Errorf("coordinator returned %s: %s", res.Status, bytes.TrimSpace(b))
+	}
+	if out == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
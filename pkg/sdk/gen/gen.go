@@ -0,0 +1,587 @@
+// Package gen generates Command manifests from the option structs of Go handlers, so that option names can't drift from the code using them.
+// Structs are marked with comments, as for Kubernetes' code-generator, and their exported fields are the options of the command,
+// named and typed as for sdk.Interaction.Bind.
+//
+//	// +powergrid:command:name=mod ban
+//	// +powergrid:command:description=Ban a member
+//	// +powergrid:command:description:de=Ein Mitglied bannen
+//	type Ban struct {
+//		// +powergrid:option:description=Member to ban
+//		// +powergrid:option:required
+//		User *discordgo.User
+//		// Days of messages to delete
+//		// +powergrid:option:max=7
+//		DeleteDays int `powergrid:"days"`
+//		// +powergrid:option:description=Reason for the ban
+//		// +powergrid:option:choice=spam=Spam
+//		// +powergrid:option:choice:de=spam=Spam
+//		Reason string
+//	}
+//
+// Markers are +powergrid:<target>:<key>[:<locale>]=<value>, where the target is command or option.
+// Boolean markers can leave out the value. Descriptions default to the doc comment without markers.
+//
+// Command markers are name, description, type (chat, user or message), nsfw, dmPermission and defaultMemberPermissions,
+// and the Command spec's serviceName, path, transport and shouldSendDeferred.
+// Names with several words are subcommands, e.g. "mod ban", or subcommands in a group, e.g. "mod admin ban".
+// Structs without fields can describe their parent commands and groups, and set the markers only allowed on top-level commands.
+//
+// Option markers are name (localized only, use a powergrid tag to rename), description, required, autocomplete,
+// choice=<value>=<name>, min, max, minLength, maxLength, channelTypes (e.g. 0,2), and type for string fields holding IDs
+// (user, channel, role or mentionable).
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	powergridv10 "github.com/sportshead/powergrid/pkg/apis/powergrid.sportshead.dev/v10"
+	"github.com/sportshead/powergrid/pkg/sdk"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	markerPrefix  = "+powergrid:"
+	discordgoPath = "github.com/bwmarrin/discordgo"
+)
+
+// Command is a command generated from the structs of its command, subcommands and groups.
+type Command struct {
+	Command *discordgo.ApplicationCommand
+	// Spec is set by the markers of the top-level command, without spec.command.
+	Spec powergridv10.CommandSpec
+	// Source is the position of the struct of the top-level command, or else of its first subcommand.
+	Source token.Position
+}
+
+// commandTypes are the values of the command type marker.
+var commandTypes = map[string]discordgo.ApplicationCommandType{
+	"chat":    discordgo.ChatApplicationCommand,
+	"user":    discordgo.UserApplicationCommand,
+	"message": discordgo.MessageApplicationCommand,
+}
+
+// idTypes are the values of the option type marker, for string fields holding the ID.
+var idTypes = map[string]discordgo.ApplicationCommandOptionType{
+	"user":        discordgo.ApplicationCommandOptionUser,
+	"channel":     discordgo.ApplicationCommandOptionChannel,
+	"role":        discordgo.ApplicationCommandOptionRole,
+	"mentionable": discordgo.ApplicationCommandOptionMentionable,
+}
+
+type marker struct {
+	pos    token.Pos
+	target string
+	key    string
+	locale string
+	value  string
+}
+
+// entry is a command, subcommand group or subcommand defined by a struct.
+type entry struct {
+	pos                      token.Pos
+	words                    []string
+	command                  *discordgo.ApplicationCommand
+	nameLocalizations        map[discordgo.Locale]string
+	descriptionLocalizations map[discordgo.Locale]string
+	spec                     powergridv10.CommandSpec
+	// topLevel is the position of the first marker only allowed on top-level commands.
+	topLevel token.Pos
+}
+
+type generator struct {
+	fset    *token.FileSet
+	entries []*entry
+	errs    []error
+}
+
+// Generate reads the marked structs in the Go files of the package in dir, returning their commands in the order they are defined.
+// Test files and files excluded by build constraints are skipped.
+func Generate(dir string) ([]*Command, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{fset: token.NewFileSet()}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".go") || strings.HasSuffix(file.Name(), "_test.go") {
+			continue
+		}
+		if ok, err := build.Default.MatchFile(dir, file.Name()); err != nil || !ok {
+			continue
+		}
+
+		f, err := parser.ParseFile(g.fset, filepath.Join(dir, file.Name()), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		g.file(f)
+	}
+
+	commands := g.commands()
+	if len(g.errs) > 0 {
+		return nil, errors.Join(g.errs...)
+	}
+	return commands, nil
+}
+
+func (g *generator) errorf(pos token.Pos, format string, args ...interface{}) {
+	g.errs = append(g.errs, fmt.Errorf("%s: %s", g.fset.Position(pos), fmt.Sprintf(format, args...)))
+}
+
+func (g *generator) file(f *ast.File) {
+	// the names the file imports packages as
+	imports := make(map[string]string)
+	for _, spec := range f.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.TypeSpec)
+			doc := spec.Doc
+			if doc == nil && len(decl.Specs) == 1 {
+				doc = decl.Doc
+			}
+			g.typeSpec(spec, doc, imports)
+		}
+	}
+}
+
+func (g *generator) typeSpec(spec *ast.TypeSpec, doc *ast.CommentGroup, imports map[string]string) {
+	markers, text := g.markers(doc)
+	if len(markers) == 0 {
+		return
+	}
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		g.errorf(spec.Pos(), "%s has markers, but is not a struct", spec.Name.Name)
+		return
+	}
+
+	e := &entry{pos: spec.Pos(), command: &discordgo.ApplicationCommand{Type: discordgo.ChatApplicationCommand}}
+	var hasDescription bool
+	for _, m := range markers {
+		if m.target != "command" {
+			g.errorf(m.pos, "%s markers must be on fields", m.target)
+			continue
+		}
+		if m.locale != "" && m.key != "name" && m.key != "description" {
+			g.errorf(m.pos, "%s cannot be localized", m.key)
+			continue
+		}
+		var err error
+		switch m.key {
+		case "name":
+			if m.locale != "" {
+				localize(&e.nameLocalizations, m.locale, m.value)
+				continue
+			}
+			e.words = strings.Fields(m.value)
+		case "description":
+			if m.locale != "" {
+				localize(&e.descriptionLocalizations, m.locale, m.value)
+				continue
+			}
+			e.command.Description = m.value
+			hasDescription = true
+		case "type":
+			var ok bool
+			if e.command.Type, ok = commandTypes[m.value]; !ok {
+				err = fmt.Errorf("unknown command type %q", m.value)
+			}
+		case "nsfw":
+			e.command.NSFW, err = boolPointer(m.value)
+		case "dmPermission":
+			e.command.DMPermission, err = boolPointer(m.value)
+		case "defaultMemberPermissions":
+			var permissions int64
+			permissions, err = strconv.ParseInt(m.value, 10, 64)
+			e.command.DefaultMemberPermissions = &permissions
+		case "serviceName":
+			e.spec.ServiceName = m.value
+		case "path":
+			e.spec.Path = m.value
+		case "transport":
+			e.spec.Transport = m.value
+		case "shouldSendDeferred":
+			e.spec.ShouldSendDeferred, err = parseBool(m.value)
+		default:
+			g.errorf(m.pos, "%s: unknown command marker", m.key)
+			continue
+		}
+		if err != nil {
+			g.errorf(m.pos, "%s: %v", m.key, err)
+		}
+		if m.key != "name" && m.key != "description" && !e.topLevel.IsValid() {
+			e.topLevel = m.pos
+		}
+	}
+
+	if len(e.words) == 0 {
+		g.errorf(spec.Pos(), "%s has no name marker", spec.Name.Name)
+		return
+	}
+	if len(e.words) > 3 {
+		g.errorf(spec.Pos(), "%q has more than a command, group and subcommand", strings.Join(e.words, " "))
+		return
+	}
+	e.command.Name = e.words[len(e.words)-1]
+	if e.nameLocalizations != nil {
+		e.command.NameLocalizations = &e.nameLocalizations
+	}
+	if e.descriptionLocalizations != nil {
+		e.command.DescriptionLocalizations = &e.descriptionLocalizations
+	}
+	if !hasDescription && e.command.Type == discordgo.ChatApplicationCommand {
+		e.command.Description = text
+	}
+
+	for _, field := range st.Fields.List {
+		e.command.Options = append(e.command.Options, g.field(field, imports)...)
+	}
+	g.entries = append(g.entries, e)
+}
+
+// field returns the options of a struct field, which can have several names.
+func (g *generator) field(field *ast.Field, imports map[string]string) []*discordgo.ApplicationCommandOption {
+	markers, text := g.markers(field.Doc)
+	if len(field.Names) == 0 {
+		g.errorf(field.Pos(), "embedded fields are not supported")
+		return nil
+	}
+	var tag reflect.StructTag
+	if field.Tag != nil {
+		value, _ := strconv.Unquote(field.Tag.Value)
+		tag = reflect.StructTag(value)
+	}
+	goType := typeName(field.Type, imports)
+
+	var options []*discordgo.ApplicationCommandOption
+	for _, ident := range field.Names {
+		name := sdk.OptionName(ident.Name, tag)
+		if !ident.IsExported() || name == "" {
+			if len(markers) > 0 {
+				g.errorf(ident.Pos(), "%s has markers, but is not an option", ident.Name)
+			}
+			continue
+		}
+
+		optionType, ok := sdk.OptionType(goType)
+		if !ok {
+			g.errorf(field.Type.Pos(), "unsupported type %s of option %s", types.ExprString(field.Type), name)
+			continue
+		}
+		option := &discordgo.ApplicationCommandOption{Type: optionType, Name: name, Description: text}
+		for _, m := range markers {
+			g.option(option, goType, m)
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+func (g *generator) option(option *discordgo.ApplicationCommandOption, goType string, m marker) {
+	if m.target != "option" {
+		g.errorf(m.pos, "%s markers must be on types", m.target)
+		return
+	}
+	if m.locale != "" && m.key != "name" && m.key != "description" && m.key != "choice" {
+		g.errorf(m.pos, "%s cannot be localized", m.key)
+		return
+	}
+
+	var err error
+	switch m.key {
+	case "name":
+		if m.locale == "" {
+			err = errors.New("use a powergrid tag to set the name")
+			break
+		}
+		localize(&option.NameLocalizations, m.locale, m.value)
+	case "description":
+		if m.locale == "" {
+			option.Description = m.value
+			break
+		}
+		localize(&option.DescriptionLocalizations, m.locale, m.value)
+	case "required":
+		option.Required, err = parseBool(m.value)
+	case "autocomplete":
+		option.Autocomplete, err = parseBool(m.value)
+	case "type":
+		var ok bool
+		if goType != "string" {
+			err = errors.New("can only be set for string fields")
+		} else if option.Type, ok = idTypes[m.value]; !ok {
+			err = fmt.Errorf("unknown option type %q", m.value)
+		}
+	case "choice":
+		err = choice(option, m)
+	case "min":
+		var min float64
+		min, err = strconv.ParseFloat(m.value, 64)
+		option.MinValue = &min
+	case "max":
+		option.MaxValue, err = strconv.ParseFloat(m.value, 64)
+	case "minLength":
+		var minLength int
+		minLength, err = strconv.Atoi(m.value)
+		option.MinLength = &minLength
+	case "maxLength":
+		option.MaxLength, err = strconv.Atoi(m.value)
+	case "channelTypes":
+		for _, value := range strings.Split(m.value, ",") {
+			var channelType int
+			channelType, err = strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				break
+			}
+			option.ChannelTypes = append(option.ChannelTypes, discordgo.ChannelType(channelType))
+		}
+	default:
+		err = errors.New("unknown option marker")
+	}
+	if err != nil {
+		g.errorf(m.pos, "%s: %v", m.key, err)
+	}
+}
+
+// choice adds the choice of a choice=<value>=<name> marker, or localizes the name of the choice with the value.
+func choice(option *discordgo.ApplicationCommandOption, m marker) error {
+	rawValue, name, found := strings.Cut(m.value, "=")
+	if !found {
+		return errors.New("must be choice=<value>=<name>")
+	}
+
+	var value interface{}
+	var err error
+	switch option.Type {
+	case discordgo.ApplicationCommandOptionString:
+		value = rawValue
+	case discordgo.ApplicationCommandOptionInteger:
+		value, err = strconv.ParseInt(rawValue, 10, 64)
+	case discordgo.ApplicationCommandOptionNumber:
+		value, err = strconv.ParseFloat(rawValue, 64)
+	default:
+		return errors.New("only string, integer and number options can have choices")
+	}
+	if err != nil {
+		return err
+	}
+
+	if m.locale == "" {
+		option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: value})
+		return nil
+	}
+	for _, c := range option.Choices {
+		if c.Value == value {
+			localize(&c.NameLocalizations, m.locale, name)
+			return nil
+		}
+	}
+	return fmt.Errorf("no choice with the value %v, localizations must follow the choice", value)
+}
+
+// commands groups the entries by their top-level command, into subcommands and groups.
+func (g *generator) commands() []*Command {
+	var names []string
+	entries := make(map[string][]*entry)
+	for _, e := range g.entries {
+		if _, ok := entries[e.words[0]]; !ok {
+			names = append(names, e.words[0])
+		}
+		entries[e.words[0]] = append(entries[e.words[0]], e)
+	}
+
+	commands := make([]*Command, 0, len(names))
+	for _, name := range names {
+		commands = append(commands, g.command(entries[name]))
+	}
+	return commands
+}
+
+func (g *generator) command(entries []*entry) *Command {
+	c := &Command{
+		Command: &discordgo.ApplicationCommand{Type: discordgo.ChatApplicationCommand, Name: entries[0].words[0]},
+		Source:  g.fset.Position(entries[0].pos),
+	}
+
+	groups := make(map[string]bool)
+	for _, e := range entries {
+		if len(e.words) == 3 {
+			groups[e.words[1]] = true
+		}
+	}
+
+	var top *entry
+	var subcommands []*discordgo.ApplicationCommandOption
+	// defined are the subcommands and groups defined by a struct, rather than by the subcommands of a group
+	defined := make(map[string]bool)
+	for _, e := range entries {
+		name := strings.Join(e.words, " ")
+		if len(e.words) == 1 {
+			if top != nil {
+				g.errorf(e.pos, "command %q is already defined at %s", name, g.fset.Position(top.pos))
+				continue
+			}
+			top = e
+			c.Command = e.command
+			c.Spec = e.spec
+			c.Source = g.fset.Position(e.pos)
+			continue
+		}
+
+		if e.topLevel.IsValid() {
+			g.errorf(e.topLevel, "only allowed on top-level commands, %q is a subcommand", name)
+		}
+		if len(e.words) == 2 && defined[e.words[1]] {
+			g.errorf(e.pos, "%q is already defined", name)
+			continue
+		}
+		if len(e.words) == 2 && groups[e.words[1]] && len(e.command.Options) > 0 {
+			g.errorf(e.pos, "%q has subcommands, so it can't have options", name)
+			continue
+		}
+
+		i := -1
+		for j, option := range subcommands {
+			if option.Name == e.words[1] {
+				i = j
+			}
+		}
+		if i == -1 {
+			subcommands = append(subcommands, &discordgo.ApplicationCommandOption{Type: discordgo.ApplicationCommandOptionSubCommand, Name: e.words[1]})
+			i = len(subcommands) - 1
+		}
+		if groups[e.words[1]] {
+			subcommands[i].Type = discordgo.ApplicationCommandOptionSubCommandGroup
+		}
+
+		if len(e.words) == 2 {
+			defined[e.words[1]] = true
+			setSubcommand(subcommands[i], e.command)
+			continue
+		}
+		subcommand := &discordgo.ApplicationCommandOption{Type: discordgo.ApplicationCommandOptionSubCommand}
+		setSubcommand(subcommand, e.command)
+		subcommands[i].Options = append(subcommands[i].Options, subcommand)
+	}
+
+	if len(subcommands) > 0 {
+		if top != nil && len(top.command.Options) > 0 {
+			g.errorf(top.pos, "command %q has subcommands, so it can't have options", top.command.Name)
+		}
+		c.Command.Options = subcommands
+	}
+	return c
+}
+
+// setSubcommand sets the option of a subcommand or group from the command of its struct. Subcommands of groups are kept.
+func setSubcommand(option *discordgo.ApplicationCommandOption, command *discordgo.ApplicationCommand) {
+	option.Name = command.Name
+	option.Description = command.Description
+	if command.NameLocalizations != nil {
+		option.NameLocalizations = *command.NameLocalizations
+	}
+	if command.DescriptionLocalizations != nil {
+		option.DescriptionLocalizations = *command.DescriptionLocalizations
+	}
+	if option.Type == discordgo.ApplicationCommandOptionSubCommand {
+		option.Options = command.Options
+	}
+}
+
+// markers returns the markers in the comment, and its text without them.
+func (g *generator) markers(doc *ast.CommentGroup) ([]marker, string) {
+	if doc == nil {
+		return nil, ""
+	}
+
+	var markers []marker
+	for _, comment := range doc.List {
+		line := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+		rest, ok := strings.CutPrefix(line, markerPrefix)
+		if !ok {
+			continue
+		}
+		key, value, _ := strings.Cut(rest, "=")
+		parts := strings.Split(key, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[1] == "" {
+			g.errorf(comment.Pos(), "invalid marker %q, must be %s<target>:<key>[:<locale>]=<value>", line, markerPrefix)
+			continue
+		}
+
+		m := marker{pos: comment.Pos(), target: parts[0], key: parts[1], value: value}
+		if len(parts) == 3 {
+			m.locale = parts[2]
+		}
+		markers = append(markers, m)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(doc.Text(), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "+") {
+			lines = append(lines, line)
+		}
+	}
+	return markers, strings.Join(lines, " ")
+}
+
+// typeName returns the name of the type as printed by reflect, e.g. *discordgo.User, or an empty string for types which can't be options.
+func typeName(expr ast.Expr, imports map[string]string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		if name := typeName(t.X, imports); name != "" {
+			return "*" + name
+		}
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok && imports[x.Name] == discordgoPath {
+			return "discordgo." + t.Sel.Name
+		}
+	}
+	return ""
+}
+
+func localize(m *map[discordgo.Locale]string, locale string, value string) {
+	if *m == nil {
+		*m = make(map[discordgo.Locale]string)
+	}
+	(*m)[discordgo.Locale(locale)] = value
+}
+
+// parseBool parses the value of a boolean marker, which is true if empty.
+func parseBool(value string) (bool, error) {
+	if value == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+func boolPointer(value string) (*bool, error) {
+	b, err := parseBool(value)
+	return &b, err
+}
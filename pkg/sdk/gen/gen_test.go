@@ -0,0 +1,281 @@
+package gen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const header = `package commands
+
+import "github.com/bwmarrin/discordgo"
+
+var _ *discordgo.User
+`
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		// want is the JSON of the generated discordgo commands
+		want string
+		// wantSpec is the JSON of the spec of the first command, if set
+		wantSpec string
+		// wantErr is part of the error, if generating should fail
+		wantErr string
+	}{
+		{
+			name: "options",
+			source: `
+// +powergrid:command:name=ban
+// +powergrid:command:description=Ban a member
+// +powergrid:command:description:de=Ein Mitglied bannen
+type Ban struct {
+	// +powergrid:option:description=Member to ban
+	// +powergrid:option:required
+	User *discordgo.User
+	// Days of messages to delete
+	// +powergrid:option:min=0
+	// +powergrid:option:max=7
+	DeleteDays int ` + "`powergrid:\"days\"`" + `
+	// Reason for the ban
+	// +powergrid:option:choice=spam=Spam
+	// +powergrid:option:choice:de=spam=Werbung
+	// +powergrid:option:maxLength=100
+	Reason *string
+	// Channel ID to log to
+	// +powergrid:option:type=channel
+	// +powergrid:option:channelTypes=0, 5
+	Log string
+	ignored string
+	Skipped bool ` + "`powergrid:\"-\"`" + `
+}
+`,
+			want: `[{"name":"ban","type":1,"description":"Ban a member","description_localizations":{"de":"Ein Mitglied bannen"},"options":[` +
+				`{"type":6,"name":"user","description":"Member to ban","required":true},` +
+				`{"type":4,"name":"days","description":"Days of messages to delete","min_value":0,"max_value":7},` +
+				`{"type":3,"name":"reason","description":"Reason for the ban","choices":[{"name":"Spam","name_localizations":{"de":"Werbung"},"value":"spam"}],"max_length":100},` +
+				`{"type":7,"name":"log","description":"Channel ID to log to","channel_types":[0,5]}]}]`,
+		},
+		{
+			name: "spec",
+			source: `
+// Ping the bot
+// +powergrid:command:name=ping
+// +powergrid:command:serviceName=pinger
+// +powergrid:command:path=/interactions/{command}
+// +powergrid:command:shouldSendDeferred
+// +powergrid:command:nsfw
+type Ping struct{}
+`,
+			want:     `[{"name":"ping","type":1,"description":"Ping the bot","nsfw":true}]`,
+			wantSpec: `{"serviceName":"pinger","path":"/interactions/{command}","shouldSendDeferred":true}`,
+		},
+		{
+			name: "subcommands and groups",
+			source: `
+// Moderation
+// +powergrid:command:name=mod
+// +powergrid:command:defaultMemberPermissions=4
+type Mod struct{}
+
+// Kick a member
+// +powergrid:command:name=mod kick
+type Kick struct {
+	User *discordgo.Member
+}
+
+// Admin commands
+// +powergrid:command:name=mod admin
+type Admin struct{}
+
+// Purge messages
+// +powergrid:command:name=mod admin purge
+type Purge struct {
+	Count int64
+}
+`,
+			want: `[{"name":"mod","type":1,"default_member_permissions":"4","description":"Moderation","options":[` +
+				`{"type":1,"name":"kick","description":"Kick a member","options":[{"type":6,"name":"user"}]},` +
+				`{"type":2,"name":"admin","description":"Admin commands","options":[` +
+				`{"type":1,"name":"purge","description":"Purge messages","options":[{"type":4,"name":"count"}]}]}]}]`,
+		},
+		{
+			name: "user command",
+			source: `
+// +powergrid:command:name=Report
+// +powergrid:command:type=user
+type Report struct{}
+`,
+			want: `[{"name":"Report","type":2}]`,
+		},
+		{
+			name: "unmarked structs are skipped",
+			source: `
+// Options is not a command
+type Options struct {
+	Value string
+}
+`,
+			want: `null`,
+		},
+		{
+			name: "missing name",
+			source: `
+// +powergrid:command:description=No name
+type Nameless struct{}
+`,
+			wantErr: "Nameless has no name marker",
+		},
+		{
+			name: "unsupported type",
+			source: `
+// +powergrid:command:name=bad
+type Bad struct {
+	Values []string
+}
+`,
+			wantErr: "unsupported type []string of option values",
+		},
+		{
+			name: "top-level marker on subcommand",
+			source: `
+// +powergrid:command:name=mod kick
+// +powergrid:command:serviceName=mod
+type Kick struct{}
+`,
+			wantErr: `only allowed on top-level commands, "mod kick" is a subcommand`,
+		},
+		{
+			name: "options on a command with subcommands",
+			source: `
+// +powergrid:command:name=mod
+type Mod struct {
+	Reason string
+}
+
+// +powergrid:command:name=mod kick
+type Kick struct{}
+`,
+			wantErr: `command "mod" has subcommands, so it can't have options`,
+		},
+		{
+			name: "type marker on a non-string field",
+			source: `
+// +powergrid:command:name=bad
+type Bad struct {
+	// +powergrid:option:type=user
+	Count int
+}
+`,
+			wantErr: "type: can only be set for string fields",
+		},
+		{
+			name: "localized choice before the choice",
+			source: `
+// +powergrid:command:name=bad
+type Bad struct {
+	// +powergrid:option:choice:de=a=A
+	Value string
+}
+`,
+			wantErr: "no choice with the value a",
+		},
+		{
+			name: "invalid marker",
+			source: `
+// +powergrid:command
+type Bad struct{}
+`,
+			wantErr: "invalid marker",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "commands.go"), []byte(header+test.source), 0o600)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			commands, err := Generate(dir)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("Generate() error = %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			var got []interface{}
+			for _, c := range commands {
+				got = append(got, c.Command)
+			}
+			assertJSON(t, got, test.want)
+			if test.wantSpec != "" {
+				assertJSON(t, commands[0].Spec, test.wantSpec)
+			}
+		})
+	}
+}
+
+func TestGenerateSkipsTestFiles(t *testing.T) {
+	dir := t.TempDir()
+	source := header + `
+// +powergrid:command:name=test
+type Test struct{}
+`
+	err := os.WriteFile(filepath.Join(dir, "commands_test.go"), []byte(source), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commands, err := Generate(dir)
+	if err != nil || len(commands) != 0 {
+		t.Errorf("Generate() = %v, %v, want no commands", commands, err)
+	}
+}
+
+// assertJSON compares the JSON of got with want, ignoring formatting and the null and false fields discordgo doesn't omit.
+func assertJSON(t *testing.T, got interface{}, want string) {
+	t.Helper()
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotValue, wantValue interface{}
+	if err = json.Unmarshal(b, &gotValue); err != nil {
+		t.Fatal(err)
+	}
+	gotValue = prune(gotValue)
+	if err = json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("invalid want JSON: %v", err)
+	}
+	gotJSON, _ := json.Marshal(gotValue)
+	wantJSON, _ := json.Marshal(wantValue)
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("got %s\nwant %s", gotJSON, wantJSON)
+	}
+}
+
+// prune removes null and false fields from decoded JSON objects.
+func prune(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if value == nil || value == false {
+				delete(v, key)
+				continue
+			}
+			v[key] = prune(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = prune(value)
+		}
+	}
+	return v
+}
@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/pkg/powergrid"
+	"net/http"
+	"strings"
+)
+
+// Interaction is an interaction forwarded by the coordinator.
+type Interaction struct {
+	*discordgo.Interaction
+	// Body is the JSON of the interaction as sent by Discord, with fields discordgo doesn't decode, such as entitlements.
+	Body []byte
+	// Deferred is set if the coordinator has already answered the interaction with a deferred response.
+	Deferred bool
+
+	// Name is the full name of the command, e.g. "mod ban", or the name part of a component or modal's custom_id.
+	Name string
+	// Options are the options of the command, or of the subcommand if there is one, by name.
+	Options map[string]*discordgo.ApplicationCommandInteractionDataOption
+	// Prefix is the first part of a component or modal's custom_id, which the coordinator routed the interaction by.
+	Prefix string
+	// Args are the parts of a component or modal's custom_id after the name.
+	Args []string
+
+	handler *Handler
+}
+
+func newInteraction(h *Handler, r *http.Request, body []byte) (*Interaction, error) {
+	i := &Interaction{
+		Interaction: &discordgo.Interaction{},
+		Body:        body,
+		Deferred:    r.Header.Get(powergrid.HeaderDeferred) == "true",
+		Options:     make(map[string]*discordgo.ApplicationCommandInteractionDataOption),
+		handler:     h,
+	}
+	err := i.Interaction.UnmarshalJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var customID string
+	switch i.Type {
+	case discordgo.InteractionApplicationCommand, discordgo.InteractionApplicationCommandAutocomplete:
+		name, options := powergrid.FullCommand(i.ApplicationCommandData())
+		i.Name = strings.Join(name, " ")
+		for _, option := range options {
+			i.Options[option.Name] = option
+		}
+		return i, nil
+	case discordgo.InteractionMessageComponent:
+		customID = i.MessageComponentData().CustomID
+	case discordgo.InteractionModalSubmit:
+		customID = i.ModalSubmitData().CustomID
+	default:
+		return i, nil
+	}
+
+	parts := strings.Split(customID, powergrid.CustomIDSeparator)
+	i.Prefix = parts[0]
+	if len(parts) > 1 {
+		i.Name = parts[1]
+		i.Args = parts[2:]
+	}
+	return i, nil
+}
+
+// CustomID returns a custom_id for a component or modal with the name and args, routed to the same prefix as this interaction,
+// or Handler.Prefix for commands.
+func (i *Interaction) CustomID(name string, args ...string) string {
+	prefix := i.Prefix
+	if prefix == "" {
+		prefix = i.handler.Prefix
+	}
+	return powergrid.CustomID(prefix, append([]string{name}, args...)...)
+}
+
+// UserID returns the ID of the user who triggered the interaction, in either a guild or a DM.
+func (i *Interaction) UserID() string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
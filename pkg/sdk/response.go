@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// Message responds with a message.
+func Message(content string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content},
+	}
+}
+
+// EphemeralMessage responds with a message only the user can see.
+func EphemeralMessage(content string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	}
+}
+
+// Deferred responds with a loading state, to be replaced with Interaction.EditResponse.
+// Commands with shouldSendDeferred set are deferred by the coordinator instead.
+func Deferred(ephemeral bool) *discordgo.InteractionResponse {
+	res := &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource}
+	if ephemeral {
+		res.Data = &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}
+	}
+	return res
+}
+
+// UpdateMessage edits the message a component is on.
+func UpdateMessage(data *discordgo.InteractionResponseData) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: data,
+	}
+}
+
+// Choices responds to an autocomplete interaction. Discord shows at most 25 choices.
+func Choices(choices ...*discordgo.ApplicationCommandOptionChoice) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	}
+}
+
+// Modal responds with a modal, whose custom_id should be made with Interaction.CustomID or powergrid.CustomID so it is routed back to the service.
+func Modal(customID string, title string, components ...discordgo.MessageComponent) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID:   customID,
+			Title:      title,
+			Components: components,
+		},
+	}
+}
+
+// webhookEdit converts the data of a response to an edit of a deferred response.
+func webhookEdit(data *discordgo.InteractionResponseData) *discordgo.WebhookEdit {
+	edit := &discordgo.WebhookEdit{
+		Content:         &data.Content,
+		AllowedMentions: data.AllowedMentions,
+	}
+	if data.Components != nil {
+		edit.Components = &data.Components
+	}
+	if data.Embeds != nil {
+		edit.Embeds = &data.Embeds
+	}
+	return edit
+}
@@ -0,0 +1,209 @@
+// Package sdk is for writing powergrid services in Go. Handler is an http.Handler which decodes the interactions forwarded by the coordinator,
+// and dispatches them to the handlers registered for commands, autocomplete, components and modals.
+//
+//	h := sdk.NewHandler()
+//	h.CoordinatorURL = "http://powergrid.powergrid:8001"
+//	h.Command("ping", func(ctx context.Context, i *sdk.Interaction) (*discordgo.InteractionResponse, error) {
+//		return sdk.Message("pong"), nil
+//	})
+//	h.Component("close", func(ctx context.Context, i *sdk.Interaction) (*discordgo.InteractionResponse, error) {
+//		// custom_id tickets/close/123 has the args ["123"]
+//		return sdk.UpdateMessage(&discordgo.InteractionResponseData{Content: "closed ticket " + i.Args[0]}), nil
+//	})
+//	http.ListenAndServe(":8080", h)
+//
+// Components and modals follow powergrid's custom_id convention of prefix/name/args..., where the prefix is routed by the coordinator,
+// the name selects the handler, and the args are passed to it.
+package sdk
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/sportshead/powergrid/pkg/utils"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// HandlerFunc handles an interaction, returning the response to it.
+// If the coordinator has already deferred the interaction, the response is used to edit the original response instead, and may be nil.
+type HandlerFunc func(ctx context.Context, i *Interaction) (*discordgo.InteractionResponse, error)
+
+// ErrNoHandler is returned by ServeHTTP for interactions without a registered handler, which the coordinator answers with an error message.
+var ErrNoHandler = errors.New("no handler for interaction")
+
+// Handler dispatches interactions to the registered handlers. Handlers must be registered before serving.
+type Handler struct {
+	// PublicKey is the application's public key. If set, interactions must be signed by Discord, whose signature the coordinator passes on.
+	PublicKey ed25519.PublicKey
+	// Prefix is the custom_id prefix routed to the service, i.e. the name of the service or the prefix of its ComponentRoute.
+	// It is used by Interaction.CustomID for commands, whose interactions have no prefix.
+	Prefix string
+	// CoordinatorURL is the URL of the coordinator's internal API, which follow up messages are sent through, e.g. http://powergrid.powergrid:8001
+	CoordinatorURL string
+	// Client sends requests to the coordinator, defaults to http.DefaultClient.
+	Client *http.Client
+	// Logger logs failed interactions, defaults to slog.Default().
+	Logger *slog.Logger
+
+	commands      map[string]HandlerFunc
+	autocompletes map[string]HandlerFunc
+	components    map[string]HandlerFunc
+	modals        map[string]HandlerFunc
+}
+
+func NewHandler() *Handler {
+	return &Handler{
+		commands:      make(map[string]HandlerFunc),
+		autocompletes: make(map[string]HandlerFunc),
+		components:    make(map[string]HandlerFunc),
+		modals:        make(map[string]HandlerFunc),
+	}
+}
+
+// Command handles the command. Subcommands can be handled separately by their full name, e.g. "mod ban",
+// otherwise the handler of the closest parent is used.
+func (h *Handler) Command(name string, f HandlerFunc) {
+	h.commands[name] = f
+}
+
+// Autocomplete handles autocomplete interactions for the command, named as for Command.
+func (h *Handler) Autocomplete(name string, f HandlerFunc) {
+	h.autocompletes[name] = f
+}
+
+// Component handles message components with the name as the second part of their custom_id, after the prefix.
+// The empty name handles components without a name, or without another handler.
+func (h *Handler) Component(name string, f HandlerFunc) {
+	h.components[name] = f
+}
+
+// Modal handles modal submissions with the name as the second part of their custom_id, as for Component.
+func (h *Handler) Modal(name string, f HandlerFunc) {
+	h.modals[name] = f
+}
+
+func (h *Handler) logger() *slog.Logger {
+	if h.Logger != nil {
+		return h.Logger
+	}
+	return slog.Default()
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.PublicKey != nil && !discordgo.VerifyInteraction(r, h.PublicKey) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+	i, err := newInteraction(h, r, body)
+	if err != nil {
+		http.Error(w, "failed to unmarshal json", http.StatusBadRequest)
+		return
+	}
+	log := h.logger().With(slog.String("id", i.ID), slog.String("name", i.Name))
+
+	if i.Type == discordgo.InteractionPing {
+		writeResponse(log, w, &discordgo.InteractionResponse{Type: discordgo.InteractionResponsePong})
+		return
+	}
+
+	f := h.handler(i)
+	if f == nil {
+		log.Error("no handler for interaction", utils.Tag("sdk_no_handler"), slog.String("type", i.Type.String()))
+		http.Error(w, ErrNoHandler.Error(), http.StatusNotFound)
+		return
+	}
+
+	res, err := f(r.Context(), i)
+	if err != nil {
+		log.Error("interaction handler failed", utils.Tag("sdk_handler_failed"), utils.Error(err))
+		http.Error(w, "interaction handler failed", http.StatusInternalServerError)
+		return
+	}
+
+	if i.Deferred {
+		// the coordinator ignores the response, so the deferred response is edited instead
+		if res != nil && res.Data != nil {
+			_, err = i.EditResponse(r.Context(), webhookEdit(res.Data))
+			if err != nil {
+				log.Error("failed to edit deferred response", utils.Tag("sdk_edit_failed"), utils.Error(err))
+				http.Error(w, "failed to edit deferred response", http.StatusBadGateway)
+				return
+			}
+		}
+		writeResponse(log, w, struct{}{})
+		return
+	}
+	if res == nil {
+		log.Error("interaction handler returned no response", utils.Tag("sdk_no_response"))
+		http.Error(w, "interaction handler returned no response", http.StatusInternalServerError)
+		return
+	}
+	writeResponse(log, w, res)
+}
+
+// handler returns the handler registered for the interaction, or nil.
+func (h *Handler) handler(i *Interaction) HandlerFunc {
+	switch i.Type {
+	case discordgo.InteractionApplicationCommand, discordgo.InteractionApplicationCommandAutocomplete:
+		handlers := h.commands
+		if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+			handlers = h.autocompletes
+		}
+		// the closest parent of subcommands, e.g. "mod ban", then "mod"
+		name := i.Name
+		for {
+			if f, ok := handlers[name]; ok {
+				return f
+			}
+			var found bool
+			if name, _, found = cutLast(name, " "); !found {
+				return nil
+			}
+		}
+	case discordgo.InteractionMessageComponent, discordgo.InteractionModalSubmit:
+		handlers := h.components
+		if i.Type == discordgo.InteractionModalSubmit {
+			handlers = h.modals
+		}
+		if f, ok := handlers[i.Name]; ok {
+			return f
+		}
+		return handlers[""]
+	}
+	return nil
+}
+
+func cutLast(s string, sep string) (string, string, bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+func writeResponse(log *slog.Logger, w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Error("failed to marshal response", utils.Tag("sdk_marshal_failed"), utils.Error(err))
+		http.Error(w, fmt.Sprintf("failed to marshal response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", utils.MimeTypeJSON)
+	_, _ = w.Write(b)
+}
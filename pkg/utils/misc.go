@@ -26,3 +26,26 @@ func WaitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
 		return true // timed out
 	}
 }
+
+// Stopper is closed by whichever part of a program stops it first, e.g. a failing server or a signal.
+type Stopper struct {
+	ch   chan struct{}
+	once sync.Once
+}
+
+// NewStopper returns a Stopper which hasn't been stopped yet.
+func NewStopper() *Stopper {
+	return &Stopper{ch: make(chan struct{})}
+}
+
+// Stop closes the channel returned by Done. It is safe to call from multiple goroutines, and more than once.
+func (s *Stopper) Stop() {
+	s.once.Do(func() {
+		close(s.ch)
+	})
+}
+
+// Done returns a channel which is closed once Stop is called.
+func (s *Stopper) Done() <-chan struct{} {
+	return s.ch
+}